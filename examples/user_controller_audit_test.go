@@ -0,0 +1,63 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/Emptyless/go-testbuilder/testbuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type auditAssert = func(t testing.TB, user *User, err error)
+
+// TestUserController_Audit checks that every StateBuilder is needed by the tests that inherit it. Audit records the
+// failures of the tests it runs without a StateBuilder, so the builder is a TestsBuilderTB for testing.TB.
+func TestUserController_Audit(t *testing.T) {
+	t.Parallel()
+
+	builder := &testbuilder.TestsBuilderTB[UserController, stepsState, auditAssert, testing.TB]{}
+
+	builder.Register("get user failure").
+		WithStep("create mocks", func(t testing.TB, sut *UserController, state *stepsState) {
+			ctrl := gomock.NewController(t)
+
+			state.userName = "my-user"
+			state.payload = "my-payload"
+			state.user = User{Name: state.userName}
+			state.mailer = NewMockMailService(ctrl)
+			state.repository = NewMockUserRepository(ctrl)
+
+			sut.Mailer = state.mailer
+			sut.Repository = state.repository
+		}).
+		WithSpecificStep("GetUser fails", func(_ testing.TB, _ *UserController, state *stepsState) {
+			state.repository.EXPECT().GetUser(state.userName).Return(User{}, assert.AnError)
+		}).
+		WithAssertion(func(t testing.TB, user *User, err error) {
+			assert.Nil(t, user)
+			require.ErrorIs(t, err, assert.AnError)
+		})
+
+	builder.Register("success").
+		WithStep("GetUser returns user", func(_ testing.TB, _ *UserController, state *stepsState) {
+			state.repository.EXPECT().GetUser(state.userName).Return(state.user, nil)
+		}).
+		WithStep("SendMail succeeds", func(_ testing.TB, _ *UserController, state *stepsState) {
+			state.mailer.EXPECT().SendMail().Return(nil)
+		}).
+		WithStep("StoreUser succeeds", func(_ testing.TB, _ *UserController, state *stepsState) {
+			state.repository.EXPECT().StoreUser(state.user).Return(nil)
+		}).
+		WithAssertion(func(t testing.TB, user *User, err error) {
+			require.NoError(t, err)
+			assert.Equal(t, &User{Name: "my-user"}, user)
+		})
+
+	findings := testbuilder.Audit(t, builder, func(t testing.TB, data testbuilder.TestData[UserController, stepsState, auditAssert]) {
+		user, err := data.SUT.Handle(data.State.userName, data.State.payload)
+		data.Assert(t, user, err)
+	})
+
+	assert.Empty(t, findings)
+}
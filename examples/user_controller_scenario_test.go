@@ -0,0 +1,121 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/Emptyless/go-testbuilder/testbuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserController_Scenario_Handle(t *testing.T) {
+	t.Parallel()
+
+	// Mocks object
+	type Mocks struct {
+		MockMailer     *MockMailService
+		MockRepository *MockUserRepository
+	}
+
+	// State object
+	type State struct {
+		// Inputs
+		userName string
+		payload  string
+
+		// Mocks
+		mocks Mocks
+
+		// Returned user
+		user User
+	}
+
+	// Result maps the return values of Handle
+	type Result struct {
+		User *User
+		Err  error
+	}
+
+	type Sut = UserController
+
+	scenario := testbuilder.Scenario[Sut, State, Result]{
+		Act: func(t *testing.T, ctrl UserController, state State) Result {
+			// Use Mocks to populate actual interfaces
+			ctrl.Mailer = state.mocks.MockMailer
+			ctrl.Repository = state.mocks.MockRepository
+
+			user, err := ctrl.Handle(state.userName, state.payload)
+
+			return Result{User: user, Err: err}
+		},
+	}
+
+	// Construct the SUT and State before any builder runs, so the first case is an ordinary case
+	scenario.WithInitial(func(t *testing.T) (UserController, State) {
+		ctrl := gomock.NewController(t)
+
+		return UserController{}, State{
+			userName: "my-user",
+			payload:  "my-payload",
+			mocks: Mocks{
+				MockMailer:     NewMockMailService(ctrl),
+				MockRepository: NewMockUserRepository(ctrl),
+			},
+			user: User{Name: "my-user"},
+		}
+	})
+
+	scenario.Register("invalid payload").
+		WithSpecificBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.payload = ""
+		}).
+		WithAssertion(func(t *testing.T, _ UserController, _ State, res Result) {
+			assert.Nil(t, res.User)
+			require.EqualError(t, res.Err, "invalid payload")
+		})
+
+	scenario.Register("get user failure").
+		WithSpecificBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockRepository.EXPECT().GetUser(state.userName).Return(User{}, assert.AnError)
+		}).
+		WithAssertion(func(t *testing.T, _ UserController, _ State, res Result) {
+			assert.Nil(t, res.User)
+			require.ErrorIs(t, res.Err, assert.AnError)
+		})
+
+	scenario.Register("send mail failure").
+		WithStateBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockRepository.EXPECT().GetUser(state.userName).Return(state.user, nil)
+		}).
+		WithSpecificBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockMailer.EXPECT().SendMail().Return(assert.AnError)
+		}).
+		WithAssertion(func(t *testing.T, _ UserController, _ State, res Result) {
+			assert.Nil(t, res.User)
+			require.ErrorIs(t, res.Err, assert.AnError)
+		})
+
+	scenario.Register("store user failure").
+		WithStateBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockMailer.EXPECT().SendMail().Return(nil)
+		}).
+		WithSpecificBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockRepository.EXPECT().StoreUser(state.user).Return(assert.AnError)
+		}).
+		WithAssertion(func(t *testing.T, _ UserController, _ State, res Result) {
+			assert.Nil(t, res.User)
+			require.ErrorIs(t, res.Err, assert.AnError)
+		})
+
+	scenario.Register("success").
+		WithStateBuilder(func(t *testing.T, sut *UserController, state *State) {
+			state.mocks.MockRepository.EXPECT().StoreUser(state.user).Return(nil)
+		}).
+		WithAssertion(func(t *testing.T, _ UserController, state State, res Result) {
+			require.NoError(t, res.Err)
+			assert.Equal(t, &state.user, res.User)
+		})
+
+	scenario.Run(t, testbuilder.Parallel())
+}
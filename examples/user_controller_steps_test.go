@@ -0,0 +1,114 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/Emptyless/go-testbuilder/testbuilder"
+	"github.com/Emptyless/go-testbuilder/testslicebuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// stepsState is the State shared by the step library below
+type stepsState struct {
+	userName string
+	payload  string
+
+	mailer     *MockMailService
+	repository *MockUserRepository
+
+	user User
+}
+
+// A per-SUT library of Steps, shared between test functions and both APIs
+var (
+	createMocks = testbuilder.NewStep("create mocks", func(t *testing.T, sut *UserController, state *stepsState) {
+		ctrl := gomock.NewController(t)
+
+		state.userName = "my-user"
+		state.payload = "my-payload"
+		state.user = User{Name: state.userName}
+		state.mailer = NewMockMailService(ctrl)
+		state.repository = NewMockUserRepository(ctrl)
+
+		sut.Mailer = state.mailer
+		sut.Repository = state.repository
+	})
+	getUserReturnsUser = testbuilder.NewStep("GetUser returns user", func(t *testing.T, _ *UserController, state *stepsState) {
+		state.repository.EXPECT().GetUser(state.userName).Return(state.user, nil)
+	})
+	getUserFails = testbuilder.NewStep("GetUser fails", func(t *testing.T, _ *UserController, state *stepsState) {
+		state.repository.EXPECT().GetUser(state.userName).Return(User{}, assert.AnError)
+	})
+	sendMailSucceeds = testbuilder.NewStep("SendMail succeeds", func(t *testing.T, _ *UserController, state *stepsState) {
+		state.mailer.EXPECT().SendMail().Return(nil)
+	})
+	storeUserSucceeds = testbuilder.NewStep("StoreUser succeeds", func(t *testing.T, _ *UserController, state *stepsState) {
+		state.repository.EXPECT().StoreUser(state.user).Return(nil)
+	})
+)
+
+type stepsAssert = func(t *testing.T, user *User, err error)
+
+// TestUserController_Steps_Handle runs the scenarios and keeps their living specification in testdata, update it with
+// -testbuilder.update. The state holds a gomock.Controller per parallel test and is snapshot by Diffs, so run it with
+// -race.
+func TestUserController_Steps_Handle(t *testing.T) {
+	t.Parallel()
+
+	builder := &testbuilder.TestsBuilder[UserController, stepsState, stepsAssert]{}
+
+	builder.Register("get user failure").
+		WithDescription("the user cannot be looked up").
+		WithSteps(createMocks).
+		WithSpecificSteps(getUserFails).
+		WithAssertion(func(t *testing.T, user *User, err error) {
+			assert.Nil(t, user)
+			require.ErrorIs(t, err, assert.AnError)
+		})
+
+	builder.Register("success").
+		WithDescription("the user is mailed and stored").
+		WithSteps(getUserReturnsUser, sendMailSucceeds, storeUserSucceeds).
+		WithAssertion(func(t *testing.T, user *User, err error) {
+			require.NoError(t, err)
+			assert.Equal(t, &User{Name: "my-user"}, user)
+		})
+
+	testbuilder.GoldenSpec(t, "user_controller_steps", builder.Spec())
+
+	builder.Run(t, func(t *testing.T, data testbuilder.TestData[UserController, stepsState, stepsAssert]) {
+		user, err := data.SUT.Handle(data.State.userName, data.State.payload)
+		data.Assert(t, user, err)
+	}, testbuilder.Parallel(), testbuilder.Diffs())
+}
+
+func TestUserController_Steps_TableTest_Handle(t *testing.T) {
+	t.Parallel()
+
+	tests := []testslicebuilder.TableTestItem[UserController, stepsState, stepsAssert]{
+		{
+			Name:          "get user failure",
+			StateSteps:    []testbuilder.Step[UserController, stepsState]{createMocks},
+			SpecificSteps: []testbuilder.Step[UserController, stepsState]{getUserFails},
+			Assertion: func(t *testing.T, user *User, err error) {
+				assert.Nil(t, user)
+				require.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			Name:       "success",
+			StateSteps: []testbuilder.Step[UserController, stepsState]{getUserReturnsUser, sendMailSucceeds, storeUserSucceeds},
+			Assertion: func(t *testing.T, user *User, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, &User{Name: "my-user"}, user)
+			},
+		},
+	}
+
+	testslicebuilder.Run(t, tests, func(t *testing.T, data testbuilder.TestData[UserController, stepsState, stepsAssert]) {
+		user, err := data.SUT.Handle(data.State.userName, data.State.payload)
+		data.Assert(t, user, err)
+	}, testbuilder.Parallel())
+}
@@ -0,0 +1,273 @@
+package testbuilder
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// AuditFinding is a StateBuilder that none of the passing TestCase's that inherit it need, see Audit
+type AuditFinding struct {
+	// Step is the StateBuilder, a TestCase with named steps has one per step
+	Step SpecStep
+	// Cases are the TestCase's that still pass without the StateBuilder
+	Cases []string
+}
+
+// String describes the finding, e.g. "state[0] get user failure: create mocks is not needed by 'success'"
+func (f AuditFinding) String() string {
+	cases := make([]string, 0, len(f.Cases))
+	for _, name := range f.Cases {
+		cases = append(cases, fmt.Sprintf("'%s'", name))
+	}
+
+	return fmt.Sprintf("%s is not needed by %s", f.Step, strings.Join(cases, ", "))
+}
+
+// auditStep identifies a StateBuilder by the index of its TestCase and its position in TestCase.steps
+type auditStep struct {
+	owner    int
+	position int
+}
+
+// auditRun is a TestCase or one of its variants that is audited
+type auditRun struct {
+	variant int
+	name    string
+}
+
+// Audit finds the StateBuilder's of ts that no TestCase needs. Every passing TestCase (and variant) is built again with
+// each StateBuilder of its chain removed, one at a time, and passed to act which exercises the SUT and asserts like Run.
+// A StateBuilder is reported, and logged, when all passing TestCase's that inherit it still pass without it. That is
+// either setup that can be removed, or an assertion that is too weak to notice it missing.
+//
+// The failures of these runs are recorded instead of reported, so the builders and act receive a testing.TB that is not
+// a *testing.T, which is why ts is a TestsBuilderTB for testing.TB. Skipped TestCase's are not audited and Checkpoints
+// are ignored.
+func Audit[SUT any, STATE any, ASSERT any](
+	t *testing.T,
+	ts *TestsBuilderTB[SUT, STATE, ASSERT, testing.TB],
+	act func(t testing.TB, data TestData[SUT, STATE, ASSERT]),
+	opts ...Option,
+) []AuditFinding {
+	t.Helper()
+
+	o := newOptions(append(slices.Clone(ts.opts), opts...))
+	// a checkpoint would restore the removed StateBuilder, and the runs are not traced or diffed
+	o.checkpoints, o.tracing, o.diffs = false, false, false
+
+	type candidate struct {
+		step    auditStep
+		finding AuditFinding
+		needed  bool
+	}
+
+	candidates := make(map[auditStep]*candidate)
+
+	for i, testcase := range ts.TestCases {
+		if _, skipped := ts.skipped(i, o); skipped {
+			continue
+		}
+
+		chain, err := ts.chain(i)
+		if err != nil {
+			continue
+		}
+
+		runs := []auditRun{{variant: noVariant, name: testcase.TestName}}
+		if len(testcase.Variants) > 0 {
+			runs = runs[:0]
+			for v, variant := range testcase.Variants {
+				runs = append(runs, auditRun{variant: v, name: testcase.TestName + "/" + variant.Name})
+			}
+		}
+
+		for _, run := range runs {
+			if !audit(t, ts, i, run.variant, o, act) {
+				continue
+			}
+
+			for _, owner := range chain {
+				for position, step := range ts.TestCases[owner].steps(KindState) {
+					key := auditStep{owner: owner, position: position}
+
+					c, ok := candidates[key]
+					if !ok {
+						c = &candidate{step: key, finding: AuditFinding{Step: SpecStep{
+							Kind:  KindState,
+							Case:  ts.TestCases[owner].TestName,
+							Index: owner,
+							Name:  step.Name,
+						}}}
+						candidates[key] = c
+					}
+
+					without := o
+					without.omit = &key
+
+					if audit(t, ts, i, run.variant, without, act) {
+						c.finding.Cases = append(c.finding.Cases, run.name)
+					} else {
+						c.needed = true
+					}
+				}
+			}
+		}
+	}
+
+	sorted := slices.SortedFunc(maps.Values(candidates), func(a, b *candidate) int {
+		return cmp.Or(cmp.Compare(a.step.owner, b.step.owner), cmp.Compare(a.step.position, b.step.position))
+	})
+
+	var findings []AuditFinding
+
+	for _, c := range sorted {
+		if c.needed {
+			continue
+		}
+
+		t.Logf("%s", c.finding)
+		findings = append(findings, c.finding)
+	}
+
+	return findings
+}
+
+// audit builds the TestCase at index and its variant and passes the TestData to act with an auditTB, it reports
+// whether the TestCase passed
+func audit[SUT any, STATE any, ASSERT any](
+	t testing.TB,
+	ts *TestsBuilderTB[SUT, STATE, ASSERT, testing.TB],
+	index int,
+	variant int,
+	o options,
+	act func(t testing.TB, data TestData[SUT, STATE, ASSERT]),
+) bool {
+	recorder := &auditTB{TB: t}
+
+	recorder.run(func() {
+		data, err := ts.build(recorder, index, variant, o)
+		if err != nil {
+			recorder.Fail()
+			return
+		}
+
+		act(recorder, data)
+	})
+
+	recorder.cleanup()
+
+	return !recorder.Failed() && !recorder.Skipped()
+}
+
+// auditTB records the failures of a run of Audit instead of reporting them. Like testing.T, FailNow and
+// SkipNow stop the goroutine of the run, see auditTB.run.
+type auditTB struct {
+	testing.TB
+
+	mu       sync.Mutex
+	failed   bool
+	skipped  bool
+	cleanups []func()
+}
+
+func (a *auditTB) Helper() {}
+
+func (a *auditTB) Fail() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.failed = true
+}
+
+func (a *auditTB) Failed() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.failed
+}
+
+func (a *auditTB) FailNow() {
+	a.Fail()
+	runtime.Goexit()
+}
+
+func (a *auditTB) Error(...any) {
+	a.Fail()
+}
+
+func (a *auditTB) Errorf(string, ...any) {
+	a.Fail()
+}
+
+func (a *auditTB) Fatal(...any) {
+	a.FailNow()
+}
+
+func (a *auditTB) Fatalf(string, ...any) {
+	a.FailNow()
+}
+
+func (a *auditTB) Log(...any) {}
+
+func (a *auditTB) Logf(string, ...any) {}
+
+func (a *auditTB) SkipNow() {
+	a.mu.Lock()
+	a.skipped = true
+	a.mu.Unlock()
+
+	runtime.Goexit()
+}
+
+func (a *auditTB) Skip(...any) {
+	a.SkipNow()
+}
+
+func (a *auditTB) Skipf(string, ...any) {
+	a.SkipNow()
+}
+
+func (a *auditTB) Skipped() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.skipped
+}
+
+func (a *auditTB) Cleanup(f func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.cleanups = append(a.cleanups, f)
+}
+
+// run f in a goroutine so that FailNow and SkipNow can stop it, a panic fails the run
+func (a *auditTB) run(f func()) {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				a.Fail()
+			}
+		}()
+
+		f()
+	}()
+
+	<-done
+}
+
+// cleanup runs the functions registered with Cleanup in reverse order, e.g. the checks of a gomock.Controller
+func (a *auditTB) cleanup() {
+	for i := len(a.cleanups) - 1; i >= 0; i-- {
+		a.run(a.cleanups[i])
+	}
+}
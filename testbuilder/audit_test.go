@@ -0,0 +1,135 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type auditAssert = func(t testing.TB, sut string)
+
+func newAuditBuilder() *TestsBuilderTB[string, string, auditAssert, testing.TB] {
+	contains := func(s string) auditAssert {
+		return func(t testing.TB, sut string) {
+			require.Contains(t, sut, s)
+		}
+	}
+
+	builder := &TestsBuilderTB[string, string, auditAssert, testing.TB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(_ testing.TB, sut *string, _ *string) {
+			*sut += "a"
+		}).
+		WithAssertion(contains("a"))
+	builder.Register("send mail failure").
+		WithStep("log in", func(_ testing.TB, _ *string, state *string) {
+			*state = "logged in"
+		}).
+		WithStep("mock send mail", func(_ testing.TB, sut *string, _ *string) {
+			*sut += "b"
+		}).
+		WithAssertion(contains("b"))
+	builder.Register("success").
+		Matrix(Vary(func(_ testing.TB, sut *string, _ *string, suffix string) {
+			*sut += suffix
+		}, "x", "y")...).
+		WithAssertion(contains("a"))
+	builder.Register("flaky").
+		WithStep("flaky setup", func(_ testing.TB, _ *string, state *string) {
+			*state = "flaky"
+		}).
+		WithAssertion(func(t testing.TB, _ string) {
+			t.Fatal("always fails")
+		})
+	builder.Register("skipped").
+		Skip("not audited").
+		WithAssertion(contains("z"))
+
+	return builder
+}
+
+func TestTestsBuilder_Audit(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newAuditBuilder()
+
+	// Act
+	findings := Audit(t, builder, func(t testing.TB, data TestData[string, string, auditAssert]) {
+		data.Assert(t, data.SUT)
+	})
+
+	// Assert
+	require.Len(t, findings, 1)
+	assert.Equal(t, SpecStep{Kind: KindState, Case: "send mail failure", Index: 1, Name: "log in"}, findings[0].Step)
+	assert.Equal(t, []string{"send mail failure", `success/"x"`, `success/"y"`}, findings[0].Cases)
+	assert.Equal(t, `state[1] send mail failure: log in is not needed by 'send mail failure', 'success/"x"', 'success/"y"'`,
+		findings[0].String())
+}
+
+func TestAuditTB_Run(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		run     func(t testing.TB)
+		failed  bool
+		skipped bool
+	}{
+		"pass": {
+			run: func(t testing.TB) {
+				t.Log("quiet")
+			},
+		},
+		"error": {
+			run: func(t testing.TB) {
+				t.Errorf("boom %d", 1)
+			},
+			failed: true,
+		},
+		"fatal stops the run": {
+			run: func(t testing.TB) {
+				t.Fatal("boom")
+				panic("unreachable")
+			},
+			failed: true,
+		},
+		"panic": {
+			run: func(_ testing.TB) {
+				panic("boom")
+			},
+			failed: true,
+		},
+		"skip": {
+			run: func(t testing.TB) {
+				t.Skip("later")
+			},
+			skipped: true,
+		},
+		"cleanup": {
+			run: func(t testing.TB) {
+				t.Cleanup(func() {
+					t.FailNow()
+				})
+			},
+			failed: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			recorder := &auditTB{TB: t}
+
+			// Act
+			recorder.run(func() {
+				tc.run(recorder)
+			})
+			recorder.cleanup()
+
+			// Assert
+			assert.Equal(t, tc.failed, recorder.Failed())
+			assert.Equal(t, tc.skipped, recorder.Skipped())
+		})
+	}
+}
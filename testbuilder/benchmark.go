@@ -0,0 +1,125 @@
+package testbuilder
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// benchmarkBatch is the number of TestData that benchmark builds with the timer stopped before measuring act on them
+const benchmarkBatch = 100
+
+// Benchmark creates a sub-benchmark for every registered TestCase of ts, and for every variant of a TestCase with
+// Variants. The TestData is built in batches with the timer stopped, so only act is measured, e.g.
+//
+//	testbuilder.Benchmark(b, builder, func(b testing.TB, data testbuilder.TestData[Sut, State, Assert]) {
+//		_, _ = data.SUT.Handle(data.State.userName, data.State.payload)
+//	})
+//
+// The functions registered with Cleanup while building and acting run after every batch, also with the timer stopped,
+// instead of piling up until the sub-benchmark ends. The builders therefore receive a testing.TB of the batch instead
+// of a *testing.T, which is why ts is a TestsBuilderTB for testing.TB.
+func Benchmark[SUT any, STATE any, ASSERT any](
+	b *testing.B,
+	ts *TestsBuilderTB[SUT, STATE, ASSERT, testing.TB],
+	act func(b testing.TB, data TestData[SUT, STATE, ASSERT]),
+	opts ...Option,
+) {
+	b.Helper()
+
+	o := newOptions(append(slices.Clone(ts.opts), opts...))
+	ts = ts.scoped()
+
+	for i, testcase := range ts.TestCases {
+		b.Run(testcase.TestName, func(b *testing.B) {
+			if len(testcase.Variants) == 0 {
+				benchmark(b, b.N, ts, i, noVariant, o, act)
+				return
+			}
+
+			for v, variant := range testcase.Variants {
+				b.Run(variant.Name, func(b *testing.B) {
+					benchmark(b, b.N, ts, i, v, o, act)
+				})
+			}
+		})
+	}
+}
+
+// benchmarkB is the part of testing.B that benchmark uses
+type benchmarkB interface {
+	testing.TB
+	StopTimer()
+	StartTimer()
+}
+
+// benchmark measures n runs of act for the TestCase at index and its variant
+func benchmark[SUT any, STATE any, ASSERT any](
+	b benchmarkB,
+	n int,
+	ts *TestsBuilderTB[SUT, STATE, ASSERT, testing.TB],
+	index int,
+	variant int,
+	o options,
+	act func(b testing.TB, data TestData[SUT, STATE, ASSERT]),
+) {
+	b.Helper()
+
+	batch := &batchTB{TB: b}
+
+	ts.skip(batch, index, o)
+
+	data := make([]TestData[SUT, STATE, ASSERT], 0, benchmarkBatch)
+
+	for done := 0; done < n; done += len(data) {
+		b.StopTimer()
+		batch.cleanup()
+
+		data = data[:0]
+		for range min(benchmarkBatch, n-done) {
+			built, err := ts.build(batch, index, variant, o)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			data = append(data, built)
+		}
+
+		b.StartTimer()
+
+		for _, built := range data {
+			act(batch, built)
+		}
+	}
+
+	b.StopTimer()
+	batch.cleanup()
+}
+
+// batchTB runs the functions registered with Cleanup when benchmark finishes a batch instead of when the benchmark
+// ends
+type batchTB struct {
+	testing.TB
+
+	mu       sync.Mutex
+	cleanups []func()
+}
+
+func (t *batchTB) Cleanup(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cleanups = append(t.cleanups, f)
+}
+
+// cleanup runs the registered functions in reverse order
+func (t *batchTB) cleanup() {
+	t.mu.Lock()
+	cleanups := t.cleanups
+	t.cleanups = nil
+	t.mu.Unlock()
+
+	for _, f := range slices.Backward(cleanups) {
+		f()
+	}
+}
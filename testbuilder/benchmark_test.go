@@ -0,0 +1,98 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timerB records whether the timer of a benchmark is running
+type timerB struct {
+	testing.TB
+
+	running bool
+}
+
+func (b *timerB) StopTimer() {
+	b.running = false
+}
+
+func (b *timerB) StartTimer() {
+	b.running = true
+}
+
+func TestBenchmark_ExcludesArrange(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	b := &timerB{TB: t, running: true}
+	timed := make(map[string]int)
+	untimed := make(map[string]int)
+	pending, maxPending := 0, 0
+
+	record := func(event string) {
+		if b.running {
+			timed[event]++
+		} else {
+			untimed[event]++
+		}
+	}
+
+	builder := TestsBuilderTB[string, string, func(), testing.TB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(t testing.TB, _ *string, _ *string) {
+			record("arrange")
+
+			pending++
+			maxPending = max(maxPending, pending)
+
+			t.Cleanup(func() {
+				record("cleanup")
+
+				pending--
+			})
+		})
+
+	// Act
+	benchmark(b, 250, &builder, 0, noVariant, options{}, func(testing.TB, TestData[string, string, func()]) {
+		record("act")
+	})
+
+	// Assert
+	assert.Equal(t, map[string]int{"act": 250}, timed)
+	assert.Equal(t, map[string]int{"arrange": 250, "cleanup": 250}, untimed)
+	assert.Zero(t, pending)
+	assert.Equal(t, benchmarkBatch, maxPending)
+	assert.False(t, b.running)
+}
+
+func TestBenchmark_SubBenchmarks(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilderTB[string, string, func(), testing.TB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(_ testing.TB, sut *string, _ *string) {
+			*sut = "get user failure"
+		})
+	builder.Register("success").
+		Matrix(Vary(func(_ testing.TB, sut *string, _ *string, n int) {
+			*sut += string(rune('0' + n))
+		}, 1, 2)...)
+
+	benchmarks := make(map[string]testing.TB)
+
+	// Act
+	testing.Benchmark(func(b *testing.B) {
+		Benchmark(b, &builder, func(b testing.TB, data TestData[string, string, func()]) {
+			benchmarks[data.SUT] = b.(*batchTB).TB
+
+			b.SkipNow() // a single iteration per sub-benchmark is enough
+		})
+	})
+
+	// Assert
+	require.Len(t, benchmarks, 3)
+	assert.NotEqual(t, benchmarks["get user failure"], benchmarks["get user failure1"])
+	assert.NotEqual(t, benchmarks["get user failure1"], benchmarks["get user failure2"])
+	assert.NotEqual(t, benchmarks["get user failure"], benchmarks["get user failure2"])
+}
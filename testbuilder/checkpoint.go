@@ -0,0 +1,162 @@
+package testbuilder
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// Cloner is implemented by a SUT or STATE that can make a deep copy of itself, see Checkpoints
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// checkpoint is the SUT and STATE after applying the StateBuilder chain up to and including a TestCase
+type checkpoint[SUT any, STATE any] struct {
+	sut   SUT
+	state STATE
+}
+
+// Checkpoints builds the StateBuilder chain once instead of re-running it for every TestCase. After applying the
+// StateBuilder of a TestCase, a deep copy of the SUT and STATE is stored as its checkpoint. Every TestCase starts from
+// a copy of the deepest checkpoint in its chain, which makes the results identical to building the chain from scratch.
+//
+// Both the SUT and the STATE must implement Cloner, use Clone or TestsBuilder.WithClone otherwise. Checkpoints are kept
+// for a single Run, Tests or Benchmark pass, and on the TestsBuilder for Build until With changes its options.
+//
+// The StateBuilder's only run once, for the test that reaches them first and with its T. Anything bound to that T, like
+// t.TempDir, t.Cleanup or gomock.NewController(t) in a StateBuilder or TestsBuilder.WithInitial, ends with that test
+// and cannot be used with Checkpoints. For the same reason a chain with a Teardown fails to build with
+// ErrInvalidOption, as its Teardown would release what later tests restore.
+func Checkpoints() Option {
+	return func(o *options) {
+		o.checkpoints = true
+	}
+}
+
+// Clone enables Checkpoints and makes deep copies of the SUT and STATE with f instead of their Cloner
+// implementations. The SUT and STATE must match the TestsBuilder the Option is applied to, otherwise building fails
+// with ErrInvalidOption. See TestsBuilder.WithClone for the type-checked variant.
+func Clone[SUT any, STATE any](f func(sut SUT, state STATE) (SUT, STATE)) Option {
+	return func(o *options) {
+		o.checkpoints = true
+		o.clone = f
+	}
+}
+
+// WithClone enables Checkpoints and makes deep copies of the SUT and STATE with f
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) WithClone(f func(sut SUT, state STATE) (SUT, STATE)) *TestsBuilderTB[SUT, STATE, ASSERT, T] {
+	return ts.With(Clone(f))
+}
+
+// resolveClone returns the function that copies the SUT and STATE for checkpoints, or nil when they are disabled
+func resolveClone[SUT any, STATE any](o options) (func(sut SUT, state STATE) (SUT, STATE), error) {
+	if !o.checkpoints {
+		return nil, nil
+	}
+
+	if o.clone != nil {
+		clone, ok := o.clone.(func(sut SUT, state STATE) (SUT, STATE))
+		if !ok {
+			return nil, fmt.Errorf("%w: Clone expects %T, got %T", ErrInvalidOption, clone, o.clone)
+		}
+
+		return clone, nil
+	}
+
+	var (
+		sut   SUT
+		state STATE
+	)
+
+	if !isCloner(sut) || !isCloner(state) {
+		return nil, fmt.Errorf("%w: Checkpoints requires %T and %T to implement Cloner, or use Clone",
+			ErrInvalidOption, sut, state)
+	}
+
+	return func(sut SUT, state STATE) (SUT, STATE) {
+		return cloneValue(sut), cloneValue(state)
+	}, nil
+}
+
+// isCloner reports whether T or *T implements Cloner[T]
+func isCloner[T any](v T) bool {
+	_, ok := any(v).(Cloner[T])
+	_, okPtr := any(&v).(Cloner[T])
+
+	return ok || okPtr
+}
+
+// cloneValue copies v with the Cloner[T] implementation of T or *T
+func cloneValue[T any](v T) T {
+	if cloner, ok := any(v).(Cloner[T]); ok {
+		return cloner.Clone()
+	}
+
+	return any(&v).(Cloner[T]).Clone() //nolint:forcetypeassert // checked by isCloner
+}
+
+// scoped returns a TestsBuilder with the TestCase's and options of ts and checkpoints of its own, which keeps the
+// checkpoints of a Run, Tests or Benchmark pass out of other passes
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) scoped() *TestsBuilderTB[SUT, STATE, ASSERT, T] {
+	return &TestsBuilderTB[SUT, STATE, ASSERT, T]{
+		TestCases:   ts.TestCases,
+		opts:        ts.opts,
+		checkpoints: &checkpoints[SUT, STATE, ASSERT, T]{},
+	}
+}
+
+// checkTeardowns returns an ErrInvalidOption when a TestCase in the chain has a Teardown, which cannot be combined with
+// checkpoints
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) checkTeardowns(chain []int) error {
+	for _, j := range chain {
+		if ts.TestCases[j].Teardown != nil {
+			return fmt.Errorf("%w: Checkpoints cannot be combined with the Teardown of case '%s' (#%d), it would release "+
+				"what later cases restore", ErrInvalidOption, ts.TestCases[j].TestName, j)
+		}
+	}
+
+	return nil
+}
+
+// checkpoints are the checkpoints of a TestsBuilder by TestCase. They are kept behind a pointer, so copies of a
+// TestsBuilder share them and their lock.
+type checkpoints[SUT any, STATE any, ASSERT any, T testing.TB] struct {
+	mu     sync.Mutex
+	byCase map[*TestCaseTB[SUT, STATE, ASSERT, T]]checkpoint[SUT, STATE]
+}
+
+// restore copies the deepest checkpoint in the chain into sut and state and returns the position in the chain to
+// continue from. It returns 0 when the chain has no checkpoint yet. The caller must hold c.mu.
+func (c *checkpoints[SUT, STATE, ASSERT, T]) restore(
+	testcases []*TestCaseTB[SUT, STATE, ASSERT, T],
+	chain []int,
+	clone func(sut SUT, state STATE) (SUT, STATE),
+	sut *SUT,
+	state *STATE,
+) int {
+	for k := len(chain) - 1; k >= 0; k-- {
+		if cp, ok := c.byCase[testcases[chain[k]]]; ok {
+			*sut, *state = clone(cp.sut, cp.state)
+
+			return k + 1
+		}
+	}
+
+	return 0
+}
+
+// store a copy of sut and state as the checkpoint of testcase. The caller must hold c.mu.
+func (c *checkpoints[SUT, STATE, ASSERT, T]) store(
+	testcase *TestCaseTB[SUT, STATE, ASSERT, T],
+	clone func(sut SUT, state STATE) (SUT, STATE),
+	sut SUT,
+	state STATE,
+) {
+	if c.byCase == nil {
+		c.byCase = make(map[*TestCaseTB[SUT, STATE, ASSERT, T]]checkpoint[SUT, STATE])
+	}
+
+	sut, state = clone(sut, state)
+	c.byCase[testcase] = checkpoint[SUT, STATE]{sut: sut, state: state}
+}
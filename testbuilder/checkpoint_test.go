@@ -0,0 +1,263 @@
+package testbuilder
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clonedState struct {
+	applied []string
+}
+
+func (s clonedState) Clone() clonedState {
+	return clonedState{applied: slices.Clone(s.applied)}
+}
+
+type clonedSUT struct {
+	name string
+}
+
+func (s *clonedSUT) Clone() clonedSUT {
+	return *s
+}
+
+func TestTestsBuilder_Checkpoints_BuildsChainOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[clonedSUT, clonedState, func()]{}
+	builder.With(Checkpoints())
+
+	calls := make(map[string]int)
+
+	for _, name := range []string{"1", "2", "3", "4"} {
+		builder.Register(name).
+			WithStateBuilder(func(t *testing.T, sut *clonedSUT, state *clonedState) {
+				t.Helper()
+
+				calls[name]++
+				sut.name = name
+				state.applied = append(state.applied, "state"+name)
+			}).
+			WithSpecificBuilder(func(t *testing.T, _ *clonedSUT, state *clonedState) {
+				t.Helper()
+
+				state.applied = append(state.applied, "specific"+name) // must not leak into the checkpoint
+			})
+	}
+
+	builder.Register("branch").
+		From("2").
+		WithStateBuilder(func(t *testing.T, _ *clonedSUT, state *clonedState) {
+			t.Helper()
+
+			calls["branch"]++
+			state.applied = append(state.applied, "stateBranch")
+		})
+
+	results := map[string][]string{
+		"1":      {"state1", "specific1"},
+		"2":      {"state1", "state2", "specific2"},
+		"3":      {"state1", "state2", "state3", "specific3"},
+		"4":      {"state1", "state2", "state3", "state4", "specific4"},
+		"branch": {"state1", "state2", "stateBranch"},
+	}
+
+	// Act
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			testData := testBuilder(t)
+
+			// Assert
+			assert.Equal(t, results[testName], testData.State.applied)
+		})
+	}
+
+	// Assert
+	assert.Equal(t, map[string]int{"1": 1, "2": 1, "3": 1, "4": 1, "branch": 1}, calls)
+}
+
+func TestTestsBuilder_WithClone(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, []string, func()]{}
+	clones := 0
+
+	builder.WithClone(func(sut string, state []string) (string, []string) {
+		clones++
+
+		return sut, slices.Clone(state)
+	})
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "state1")
+		})
+	builder.Register("2").
+		WithStateBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "state2")
+		})
+
+	// Act
+	first, err := builder.Build(t, 1)
+	require.NoError(t, err)
+
+	second, err := builder.Build(t, 1)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"state1", "state2"}, first.State)
+	assert.Equal(t, []string{"state1", "state2"}, second.State)
+	assert.Equal(t, 3, clones) // two checkpoints and one restore
+}
+
+func TestTestsBuilder_Checkpoints_InvalidOption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a Cloner", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		builder := TestsBuilder[string, clonedState, func()]{}
+		builder.With(Checkpoints())
+		builder.Register("1")
+
+		// Act
+		_, err := builder.Build(t, 0)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidOption)
+		assert.EqualError(t, err, "invalid option: Checkpoints requires string and testbuilder.clonedState to "+
+			"implement Cloner, or use Clone")
+	})
+
+	t.Run("clone type mismatch", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		builder := TestsBuilder[string, string, func()]{}
+		builder.With(Clone(func(sut int, state string) (int, string) {
+			return sut, state
+		}))
+		builder.Register("1")
+
+		// Act
+		_, err := builder.Build(t, 0)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidOption)
+	})
+}
+
+func TestTestsBuilder_Checkpoints_Teardown(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, []string, func()]{}
+	builder.WithClone(func(sut string, state []string) (string, []string) {
+		return sut, slices.Clone(state)
+	})
+	builder.Register("open connection").
+		WithStateBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "open")
+		}).
+		WithTeardown(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "closed")
+		})
+	builder.Register("get user failure")
+
+	// Act
+	_, err := builder.Build(t, 1)
+
+	// Assert
+	require.ErrorIs(t, err, ErrInvalidOption)
+	assert.EqualError(t, err, "invalid option: Checkpoints cannot be combined with the Teardown of case "+
+		"'open connection' (#0), it would release what later cases restore")
+}
+
+func TestTestsBuilder_Checkpoints_Scope(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, []string, func()]{}
+	builder.WithClone(func(sut string, state []string) (string, []string) {
+		return sut, slices.Clone(state)
+	})
+	builder.WithInitial(func(t *testing.T) (string, []string) {
+		t.Helper()
+
+		return "first", nil
+	})
+	builder.Register("get user failure").
+		WithStateBuilder(func(t *testing.T, sut *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, *sut)
+		})
+
+	second := Initial(func(t *testing.T) (string, []string) {
+		t.Helper()
+
+		return "second", nil
+	})
+
+	// Act
+	first, err := builder.Build(t, 0)
+	require.NoError(t, err)
+
+	var run []string
+
+	builder.Run(t, func(t *testing.T, data TestData[string, []string, func()]) {
+		t.Helper()
+
+		run = data.State
+	}, second)
+
+	builder.With(second)
+
+	rebuilt, err := builder.Build(t, 0)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"first"}, first.State)
+	assert.Equal(t, []string{"second"}, run)
+	assert.Equal(t, []string{"second"}, rebuilt.State)
+}
+
+func TestTestsBuilder_Checkpoints_Copy(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, []string, func()]{}
+	clones := 0
+
+	builder.WithClone(func(sut string, state []string) (string, []string) {
+		clones++
+
+		return sut, slices.Clone(state)
+	})
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "state1")
+		})
+
+	copied := builder // a TestsBuilder is a value, its copies share the checkpoints
+
+	// Act
+	first, err := builder.Build(t, 0)
+	require.NoError(t, err)
+
+	second, err := copied.Build(t, 0)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"state1"}, first.State)
+	assert.Equal(t, []string{"state1"}, second.State)
+	assert.Equal(t, 2, clones) // one checkpoint and one restore
+}
@@ -0,0 +1,29 @@
+package testbuilder
+
+import (
+	"testing"
+)
+
+// TestCaseConverter is implemented by the items of a table test, e.g. testslicebuilder.TableTestItem, to convert them
+// into a TestCase
+type TestCaseConverter[SUT any, STATE any, ASSERT any, T testing.TB] interface {
+	ToTestCase() *TestCaseTB[SUT, STATE, ASSERT, T]
+}
+
+// FromSlice registers every item of a table test to a new TestsBuilder, with all per-case metadata carried over. This
+// allows e.g. building a slice programmatically and running it with TestsBuilder.Run.
+//
+//	builder := testbuilder.FromSlice(tests) // tests is a []testslicebuilder.TableTestItem
+func FromSlice[SUT any, STATE any, ASSERT any, T testing.TB, ITEM TestCaseConverter[SUT, STATE, ASSERT, T]](
+	items []ITEM,
+) *TestsBuilderTB[SUT, STATE, ASSERT, T] {
+	builder := &TestsBuilderTB[SUT, STATE, ASSERT, T]{
+		TestCases: make([]*TestCaseTB[SUT, STATE, ASSERT, T], 0, len(items)),
+	}
+
+	for _, item := range items {
+		builder.TestCases = append(builder.TestCases, item.ToTestCase())
+	}
+
+	return builder
+}
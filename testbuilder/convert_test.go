@@ -0,0 +1,41 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type convertItem struct {
+	name string
+}
+
+func (i convertItem) ToTestCase() *TestCase[string, string, func()] {
+	return &TestCase[string, string, func()]{
+		TestName: i.name,
+		StateBuilder: func(t *testing.T, sut *string, _ *string) {
+			t.Helper()
+
+			*sut += i.name
+		},
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	items := []convertItem{{name: "a"}, {name: "b"}}
+
+	// Act
+	builder := FromSlice(items)
+
+	// Assert
+	require.Len(t, builder.TestCases, 2)
+	assert.Equal(t, "a", builder.TestCases[0].TestName)
+	assert.Equal(t, "b", builder.TestCases[1].TestName)
+
+	data, err := builder.Build(t, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "ab", data.SUT)
+}
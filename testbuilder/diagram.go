@@ -0,0 +1,154 @@
+package testbuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DOT renders the Spec as a Graphviz digraph with a node per TestCase. A node lists the builders the TestCase adds to
+// the chain, its variants and whether it asserts. The inherited builders are reached by following the edges back to
+// the first TestCase, a dashed "from" edge is a branch with TestCase.From.
+func (s Spec) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph spec {\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	for _, specCase := range s.Cases {
+		attributes := fmt.Sprintf("label=%q", strings.Join(specCase.diagramLines(), "\n"))
+
+		switch {
+		case specCase.Error != "":
+			attributes += ", color=red"
+		case specCase.Skip != "":
+			attributes += ", style=dashed"
+		}
+
+		fmt.Fprintf(&b, "\tcase%d [%s];\n", specCase.Index, attributes)
+	}
+
+	for _, edge := range s.edges() {
+		if edge.branch {
+			fmt.Fprintf(&b, "\tcase%d -> case%d [style=dashed, label=\"from\"];\n", edge.parent, edge.child)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\tcase%d -> case%d;\n", edge.parent, edge.child)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Mermaid renders the Spec as a Mermaid flowchart with the same nodes and edges as Spec.DOT
+func (s Spec) Mermaid() string {
+	var b strings.Builder
+
+	b.WriteString("flowchart TD\n")
+
+	for _, specCase := range s.Cases {
+		lines := specCase.diagramLines()
+		for i, line := range lines {
+			lines[i] = mermaidText(line)
+		}
+
+		fmt.Fprintf(&b, "\tcase%d[\"%s\"]\n", specCase.Index, strings.Join(lines, "<br>"))
+	}
+
+	for _, edge := range s.edges() {
+		if edge.branch {
+			fmt.Fprintf(&b, "\tcase%d -.->|from| case%d\n", edge.parent, edge.child)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\tcase%d --> case%d\n", edge.parent, edge.child)
+	}
+
+	for _, specCase := range s.Cases {
+		switch {
+		case specCase.Error != "":
+			fmt.Fprintf(&b, "\tstyle case%d stroke:red\n", specCase.Index)
+		case specCase.Skip != "":
+			fmt.Fprintf(&b, "\tstyle case%d stroke-dasharray:5 5\n", specCase.Index)
+		}
+	}
+
+	return b.String()
+}
+
+// specEdge is the inheritance of the TestCase at child from the TestCase at parent
+type specEdge struct {
+	parent int
+	child  int
+	// branch is set when child does not inherit from the TestCase registered before it, see TestCase.From
+	branch bool
+}
+
+// edges resolves the Parent of every SpecCase to an index like TestsBuilder.parent
+func (s Spec) edges() []specEdge {
+	var edges []specEdge
+
+	for _, specCase := range s.Cases {
+		if specCase.Parent == "" {
+			continue
+		}
+
+		for _, candidate := range s.Cases {
+			if candidate.Name == specCase.Parent {
+				edges = append(edges, specEdge{
+					parent: candidate.Index,
+					child:  specCase.Index,
+					branch: candidate.Index != specCase.Index-1,
+				})
+
+				break
+			}
+		}
+	}
+
+	return edges
+}
+
+// diagramLines are the lines of the node of the SpecCase, its inherited builders are left to the edges
+func (c SpecCase) diagramLines() []string {
+	lines := []string{c.title()}
+
+	for _, step := range c.Steps {
+		if step.Index != c.Index {
+			continue
+		}
+
+		if step.Name == "" {
+			lines = append(lines, string(step.Kind))
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %s", step.Kind, step.Name))
+	}
+
+	if len(c.Variants) > 0 {
+		lines = append(lines, "variants: "+strings.Join(c.Variants, ", "))
+	}
+
+	if len(c.Tags) > 0 {
+		lines = append(lines, "tags: "+strings.Join(c.Tags, ", "))
+	}
+
+	if c.Assertion {
+		lines = append(lines, "assertion")
+	} else {
+		lines = append(lines, "no assertion")
+	}
+
+	if c.Error != "" {
+		lines = append(lines, "error: "+c.Error)
+	}
+
+	return lines
+}
+
+// mermaidText escapes text for a quoted Mermaid label
+func mermaidText(text string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br>").Replace(text)
+}
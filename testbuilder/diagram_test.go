@@ -0,0 +1,67 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_DOT(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	spec := newSpecBuilder().Spec()
+
+	// Act
+	dot := spec.DOT()
+
+	// Assert
+	assert.Equal(t, "digraph spec {\n"+
+		"\tnode [shape=box];\n"+
+		`	case0 [label="invalid payload\nspecific builder\nvariants: \"\", \" \"\nno assertion"];`+"\n"+
+		`	case1 [label="get user failure\nstate builder\ntags: db\nno assertion"];`+"\n"+
+		`	case2 [label="send mail failure (skipped: flaky)\nstate builder: GetUser returns user\n`+
+		`specific builder: SendMail fails\nno assertion", style=dashed];`+"\n"+
+		`	case3 [label="admin\nstate builder\nassertion"];`+"\n"+
+		`	case4 [label="unknown\nno assertion\nerror: unknown parent: case \"unknown\" (#4) inherits from \"nobody\"", color=red];`+"\n"+
+		"\tcase0 -> case1;\n"+
+		"\tcase1 -> case2;\n"+
+		"\tcase1 -> case3 [style=dashed, label=\"from\"];\n"+
+		"}\n",
+		dot)
+}
+
+func TestSpec_Mermaid(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	spec := newSpecBuilder().Spec()
+
+	// Act
+	mermaid := spec.Mermaid()
+
+	// Assert
+	assert.Equal(t, "flowchart TD\n"+
+		"\tcase0[\"invalid payload<br>specific builder<br>variants: #quot;#quot;, #quot; #quot;<br>no assertion\"]\n"+
+		"\tcase1[\"get user failure<br>state builder<br>tags: db<br>no assertion\"]\n"+
+		"\tcase2[\"send mail failure (skipped: flaky)<br>state builder: GetUser returns user<br>"+
+		"specific builder: SendMail fails<br>no assertion\"]\n"+
+		"\tcase3[\"admin<br>state builder<br>assertion\"]\n"+
+		"\tcase4[\"unknown<br>no assertion<br>error: unknown parent: case #quot;unknown#quot; (#4) inherits from #quot;nobody#quot;\"]\n"+
+		"\tcase0 --> case1\n"+
+		"\tcase1 --> case2\n"+
+		"\tcase1 -.->|from| case3\n"+
+		"\tstyle case2 stroke-dasharray:5 5\n"+
+		"\tstyle case4 stroke:red\n",
+		mermaid)
+}
+
+func TestSpec_Mermaid_EscapesLabels(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	spec := Spec{Cases: []SpecCase{{Name: "<b>\"x\"</b>", Assertion: true}}}
+
+	// Act
+	mermaid := spec.Mermaid()
+
+	// Assert
+	assert.Equal(t, "flowchart TD\n\tcase0[\"#lt;b#gt;#quot;x#quot;#lt;/b#gt;<br>assertion\"]\n", mermaid)
+}
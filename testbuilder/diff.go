@@ -0,0 +1,79 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// DiffsEnv is the environment variable that enables Diffs for every TestsBuilder, e.g. TESTBUILDER_DIFFS=1
+const DiffsEnv = "TESTBUILDER_DIFFS"
+
+// Diffs snapshots the SUT and STATE after every builder that is applied while building a TestCase. When the test
+// fails, e.g. on an assertion, the changes made by every builder are logged as a diff, e.g.
+//
+//	after state[2] send mail failure:
+//	--- before
+//	+++ after
+//	@@ -12,3 +12,3 @@
+//	...
+//
+// The labels are the same as in the Trace output. A snapshot is bounded, see dump: it does not follow a testing.TB,
+// e.g. the *testing.T held by a gomock.Controller, or the types of the sync packages.
+func Diffs() Option {
+	return func(o *options) {
+		o.diffs = true
+	}
+}
+
+// snapshot of the SUT and STATE after the builder with label
+type snapshot struct {
+	label string
+	dump  string
+}
+
+// snapshots are taken during a single build when Diffs is enabled
+type snapshots struct {
+	list []snapshot
+}
+
+// take a snapshot of the SUT and STATE, a nil snapshots takes none
+func (s *snapshots) take(label string, sut any, state any) {
+	if s == nil {
+		return
+	}
+
+	s.list = append(s.list, snapshot{label: label, dump: "SUT: " + dump(sut) + "\nSTATE: " + dump(state) + "\n"})
+}
+
+// reportDiffs logs the changes between the snapshots when the test failed
+func (o options) reportDiffs(t testing.TB) {
+	t.Helper()
+
+	if !t.Failed() {
+		return
+	}
+
+	list := o.snapshots.list
+	for i := 1; i < len(list); i++ {
+		before, after := list[i-1], list[i]
+
+		if before.dump == after.dump {
+			t.Logf("after %s: no changes", after.label)
+			continue
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(before.dump),
+			B:        difflib.SplitLines(after.dump),
+			FromFile: "before",
+			ToFile:   "after",
+			Context:  1,
+		})
+		if err != nil {
+			diff = err.Error()
+		}
+
+		t.Logf("after %s:\n%s", after.label, diff)
+	}
+}
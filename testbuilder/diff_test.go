@@ -0,0 +1,111 @@
+package testbuilder
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type diffState struct {
+	Mails  []string
+	Called int
+}
+
+func newDiffBuilder() *TestsBuilderTB[string, diffState, func(), *recordingTB] {
+	builder := &TestsBuilderTB[string, diffState, func(), *recordingTB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(_ *recordingTB, _ *string, state *diffState) {
+			state.Called++
+		})
+	builder.Register("send mail failure").
+		WithStep("mock send mail", func(_ *recordingTB, _ *string, state *diffState) {
+			state.Mails = append(state.Mails, "welcome")
+		}).
+		WithSpecificBuilder(func(*recordingTB, *string, *diffState) {})
+
+	return builder
+}
+
+// failedTB is a recordingTB of a test that failed, e.g. on an assertion
+func failedTB() *recordingTB {
+	return &recordingTB{errors: []string{"assertion broke"}}
+}
+
+func TestDiffs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := failedTB()
+
+	builder := newDiffBuilder().With(Diffs())
+
+	// Act
+	_, err := builder.Build(recorder, 1)
+	recorder.cleanup()
+
+	// Assert
+	require.NoError(t, err)
+
+	lines := recorder.logs
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "after state[0] get user failure:\n--- before\n+++ after\n"))
+	assert.Contains(t, lines[0], "- Called: 0,\n")
+	assert.Contains(t, lines[0], "+ Called: 1,\n")
+	assert.True(t, strings.HasPrefix(lines[1], "after state[1] send mail failure: mock send mail:\n"))
+	assert.Contains(t, lines[1], "+ Mails: []string{\n+  \"welcome\",\n+ },\n")
+	assert.Equal(t, "after specific[1] send mail failure: no changes", lines[2])
+}
+
+func TestDiffs_Checkpoints(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := failedTB()
+
+	builder := newDiffBuilder().With(Diffs())
+	builder.WithClone(func(sut string, state diffState) (string, diffState) {
+		state.Mails = slices.Clone(state.Mails)
+
+		return sut, state
+	})
+
+	_, err := builder.Build(&recordingTB{}, 0)
+	require.NoError(t, err)
+
+	// Act
+	_, err = builder.Build(recorder, 1)
+	recorder.cleanup()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, recorder.logs)
+	assert.True(t, strings.HasPrefix(recorder.logs[0], "after checkpoint[0] get user failure:\n"))
+}
+
+func TestDiffs_NotFailed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := newDiffBuilder().With(Diffs())
+
+	// Act
+	_, err := builder.Build(recorder, 1)
+	recorder.cleanup()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, recorder.logs)
+}
+
+func TestDiffs_Env(t *testing.T) {
+	// Arrange
+	t.Setenv(DiffsEnv, "1")
+
+	// Act
+	o := newOptions(nil)
+
+	// Assert
+	assert.True(t, o.diffs)
+}
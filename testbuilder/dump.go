@@ -0,0 +1,227 @@
+package testbuilder
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	// maxDumpDepth is the number of pointers and nested values that dump follows
+	maxDumpDepth = 8
+	// maxDumpElements is the number of elements of a slice, array or map that dump prints
+	maxDumpElements = 100
+)
+
+// dump prints v for a snapshot of Diffs, deterministic between snapshots of the same build. It does not follow values
+// that implement testing.TB, as other tests may be writing to them, nor the types of the sync and testing packages. A
+// cycle, a value nested deeper than maxDumpDepth and the elements beyond maxDumpElements are left out as well.
+//
+// go-spew, which testify uses for its diffs, cannot skip a type or bound the elements it prints. It follows the
+// *testing.T held by a gomock.Controller in the STATE into the state of the test runner, which other parallel tests
+// write to (a data race under -race), and prints its unbounded output for every builder. Hence this dumper.
+func dump(v any) string {
+	d := &dumper{path: make(map[uintptr]bool)}
+	d.value(reflect.ValueOf(v), 0)
+
+	return d.b.String()
+}
+
+// dumper prints a value for dump
+type dumper struct {
+	b strings.Builder
+	// path are the pointers that are being printed, to detect a cycle
+	path map[uintptr]bool
+	// indent is the number of values that are open
+	indent int
+}
+
+func (d *dumper) value(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.b.WriteString("nil")
+		return
+	}
+
+	if skip, ok := skipDump(v.Type()); ok {
+		d.b.WriteString(skip)
+		return
+	}
+
+	if depth > maxDumpDepth {
+		d.b.WriteString("<max depth>")
+		return
+	}
+
+	switch v.Kind() { //nolint:exhaustive // the remaining kinds are printed by d.scalar
+	case reflect.Pointer:
+		d.pointer(v, depth)
+	case reflect.Interface:
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+
+		d.value(v.Elem(), depth)
+	case reflect.Struct:
+		d.open(v.Type())
+
+		for i := range v.NumField() {
+			d.newline()
+			d.b.WriteString(v.Type().Field(i).Name + ": ")
+			d.value(v.Field(i), depth+1)
+			d.b.WriteString(",")
+		}
+
+		d.close(v.NumField())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+
+		d.open(v.Type())
+
+		for i := range min(v.Len(), maxDumpElements) {
+			d.newline()
+			d.value(v.Index(i), depth+1)
+			d.b.WriteString(",")
+		}
+
+		d.more(v.Len())
+	case reflect.Map:
+		d.mapValue(v, depth)
+	default:
+		d.scalar(v)
+	}
+}
+
+func (d *dumper) pointer(v reflect.Value, depth int) {
+	if v.IsNil() {
+		d.b.WriteString("nil")
+		return
+	}
+
+	if d.path[v.Pointer()] {
+		d.b.WriteString("<cycle>")
+		return
+	}
+
+	d.path[v.Pointer()] = true
+	defer delete(d.path, v.Pointer())
+
+	d.b.WriteString("&")
+	d.value(v.Elem(), depth+1)
+}
+
+func (d *dumper) mapValue(v reflect.Value, depth int) {
+	if v.IsNil() {
+		d.b.WriteString("nil")
+		return
+	}
+
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+
+	entries := make([]entry, 0, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		key := &dumper{path: d.path, indent: d.indent + 1}
+		key.value(iter.Key(), depth+1)
+		entries = append(entries, entry{key: key.b.String(), value: iter.Value()})
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	d.open(v.Type())
+
+	for _, e := range entries[:min(len(entries), maxDumpElements)] {
+		d.newline()
+		d.b.WriteString(e.key + ": ")
+		d.value(e.value, depth+1)
+		d.b.WriteString(",")
+	}
+
+	d.more(len(entries))
+}
+
+// scalar prints the value of a basic kind, without Interface as v may be an unexported field
+func (d *dumper) scalar(v reflect.Value) {
+	switch v.Kind() { //nolint:exhaustive // the other kinds are printed by their type
+	case reflect.Bool:
+		d.b.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d.b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		d.b.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		d.b.WriteString(strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+	case reflect.String:
+		d.b.WriteString(strconv.Quote(v.String()))
+	default:
+		// chan, func and unsafe.Pointer, their addresses differ between runs
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+
+		d.b.WriteString("<" + v.Type().String() + ">")
+	}
+}
+
+// open a struct, slice, array or map of type t
+func (d *dumper) open(t reflect.Type) {
+	d.b.WriteString(t.String() + "{")
+	d.indent++
+}
+
+// more prints the number of elements that are left out and closes the value
+func (d *dumper) more(n int) {
+	if n > maxDumpElements {
+		d.newline()
+		fmt.Fprintf(&d.b, "... %d more", n-maxDumpElements)
+	}
+
+	d.close(n)
+}
+
+// close the value after n elements or fields
+func (d *dumper) close(n int) {
+	d.indent--
+
+	if n > 0 {
+		d.newline()
+	}
+
+	d.b.WriteString("}")
+}
+
+func (d *dumper) newline() {
+	d.b.WriteString("\n" + strings.Repeat(" ", d.indent))
+}
+
+// skipDump returns the placeholder of a type that dump does not follow, an interface is decided by its dynamic type
+func skipDump(t reflect.Type) (string, bool) {
+	if t.Kind() == reflect.Interface {
+		return "", false
+	}
+
+	if t.Implements(reflect.TypeFor[testing.TB]()) {
+		return "<" + t.String() + ">", true
+	}
+
+	switch t.PkgPath() {
+	case "sync", "sync/atomic", "testing":
+		return "<" + t.String() + ">", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,97 @@
+package testbuilder
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type dumpController struct {
+	t     testing.TB
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+type dumpNode struct {
+	Name string
+	next *dumpNode
+}
+
+func TestDump(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	node := &dumpNode{Name: "a"}
+	node.next = node
+
+	var ch chan int
+
+	value := struct {
+		Controller *dumpController
+		Node       *dumpNode
+		Tags       []string
+		Ratio      float64
+		Ready      bool
+		Done       chan int
+		Send       func()
+		Err        error
+	}{
+		Controller: &dumpController{t: t, calls: map[string]int{"StoreUser": 2, "GetUser": 1}},
+		Node:       node,
+		Tags:       []string{"db"},
+		Ratio:      0.5,
+		Ready:      true,
+		Done:       ch,
+		Send:       func() {},
+	}
+
+	// Act
+	dumped := dump(value)
+
+	// Assert
+	assert.Equal(t, `struct { Controller *testbuilder.dumpController; Node *testbuilder.dumpNode; Tags []string; `+
+		`Ratio float64; Ready bool; Done chan int; Send func(); Err error }{
+ Controller: &testbuilder.dumpController{
+  t: <*testing.T>,
+  mu: <sync.Mutex>,
+  calls: map[string]int{
+   "GetUser": 1,
+   "StoreUser": 2,
+  },
+ },
+ Node: &testbuilder.dumpNode{
+  Name: "a",
+  next: <cycle>,
+ },
+ Tags: []string{
+  "db",
+ },
+ Ratio: 0.5,
+ Ready: true,
+ Done: nil,
+ Send: <func()>,
+ Err: nil,
+}`, dumped)
+}
+
+func TestDump_Bounded(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	deep := &dumpNode{Name: "0"}
+	for i := range maxDumpDepth {
+		deep = &dumpNode{Name: strings.Repeat("x", i), next: deep}
+	}
+
+	long := make([]int, maxDumpElements+5)
+
+	// Act
+	dumpedDeep := dump(deep)
+	dumpedLong := dump(long)
+
+	// Assert
+	assert.Contains(t, dumpedDeep, "<max depth>")
+	assert.NotContains(t, dumpedDeep, `Name: "0"`)
+	assert.Equal(t, maxDumpElements+3, strings.Count(dumpedLong, "\n")+1)
+	assert.Contains(t, dumpedLong, "\n ... 5 more\n}")
+}
@@ -0,0 +1,105 @@
+package testbuilder
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for clarity and better testability
+var (
+	ErrIndexOutOfRange   = errors.New("index out of range")
+	ErrUnknownParent     = errors.New("unknown parent")
+	ErrCyclicInheritance = errors.New("cyclic inheritance")
+	ErrInvalidOption     = errors.New("invalid option")
+)
+
+// Sentinel errors of TestsBuilder.Validate, they are wrapped in a ValidationError. ErrNilAssertion is reported for an
+// Assertion that is the zero value of ASSERT, e.g. a nil func or an empty struct.
+var (
+	ErrEmptyName          = errors.New("empty name")
+	ErrDuplicateName      = errors.New("duplicate name")
+	ErrNilAssertion       = errors.New("nil assertion")
+	ErrNoBuilders         = errors.New("no builders")
+	ErrUnusedStateBuilder = errors.New("state builder is not inherited by any case")
+)
+
+// Sentinel errors of TestsBuilder.Fuzz
+var (
+	ErrTestNotFound    = errors.New("test not found")
+	ErrInvalidInjector = errors.New("invalid injector")
+)
+
+// BuilderKind distinguishes the builders of a TestCase
+type BuilderKind string
+
+const (
+	// KindState is the TestCase.StateBuilder that is inherited by further TestCase's
+	KindState BuilderKind = "state builder"
+	// KindSpecific is the TestCase.SpecificBuilder that only runs for the TestCase itself
+	KindSpecific BuilderKind = "specific builder"
+)
+
+// short is the name of the BuilderKind in the Trace output
+func (k BuilderKind) short() string {
+	if k == KindSpecific {
+		return "specific"
+	}
+
+	return "state"
+}
+
+// BuildError attributes a failing or panicking builder to the TestCase that owns it and the TestCase that was being
+// built, e.g.
+//
+//	state builder of case 'send mail failure' (#2) failed while building case 'success' (#4): ...
+//	state builder of case 'send mail failure' (#2) panicked while building case 'success' (#4): ...
+//	state builder 'mock send mail' of case 'send mail failure' (#2) failed while building case 'success' (#4): ...
+type BuildError struct {
+	// Kind of the builder that failed
+	Kind BuilderKind
+	// Step is the Name of the Step that failed, empty for the unnamed builders
+	Step string
+	// Case is the TestName of the TestCase that owns the builder
+	Case string
+	// Index of the TestCase that owns the builder
+	Index int
+	// Building is the TestName of the TestCase that was being built
+	Building string
+	// BuildingIndex is the index of the TestCase that was being built
+	BuildingIndex int
+	// Err returned by the builder, or a *PanicError when the builder panicked
+	Err error
+}
+
+func (e *BuildError) Error() string {
+	verb := "failed"
+
+	var panicErr *PanicError
+	if errors.As(e.Err, &panicErr) {
+		verb = "panicked"
+	}
+
+	builder := string(e.Kind)
+	if e.Step != "" {
+		builder += fmt.Sprintf(" '%s'", e.Step)
+	}
+
+	return fmt.Sprintf("%s of case '%s' (#%d) %s while building case '%s' (#%d): %v",
+		builder, e.Case, e.Index, verb, e.Building, e.BuildingIndex, e.Err)
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError holds the value a builder panicked with, it is wrapped in a BuildError
+type PanicError struct {
+	// Value passed to panic
+	Value any
+	// Stack of the panicking goroutine
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprint(e.Value)
+}
@@ -0,0 +1,119 @@
+package testbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// Fuzz the TestCase named caseName. For every fuzz input, the TestData of the TestCase is built like Build, after which
+// inject applies the fuzz arguments to the SUT and STATE and run acts and asserts, or checks a property of the SUT.
+//
+// The injector has the signature func(t *testing.T, sut *SUT, state *STATE, args...) with the fuzz arguments in the
+// order of testing.F.Fuzz and optionally returns an error that fails the test. Seed the corpus with testing.F.Add
+// before calling Fuzz, e.g.
+//
+//	f.Add("my-payload")
+//	builder.Fuzz(f, "success", func(t *testing.T, _ *Sut, state *State, payload string) {
+//		state.payload = payload
+//	}, func(t *testing.T, data testbuilder.TestData[Sut, State, Assert]) {
+//		user, err := data.SUT.Handle(data.State.userName, data.State.payload)
+//		...
+//	})
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Fuzz(
+	f *testing.F,
+	caseName string,
+	inject any,
+	run func(t T, data TestData[SUT, STATE, ASSERT]),
+) {
+	f.Helper()
+
+	fuzz, err := ts.fuzzFunc(caseName, inject, run)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(fuzz)
+}
+
+// fuzzFunc returns the function passed to testing.F.Fuzz
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) fuzzFunc(
+	caseName string,
+	inject any,
+	run func(t T, data TestData[SUT, STATE, ASSERT]),
+) (any, error) {
+	index := slices.IndexFunc(ts.TestCases, func(testcase *TestCaseTB[SUT, STATE, ASSERT, T]) bool {
+		return testcase.TestName == caseName
+	})
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrTestNotFound, caseName)
+	}
+
+	if err := checkInjector[SUT, STATE, T](inject); err != nil {
+		return nil, err
+	}
+
+	injector := reflect.ValueOf(inject)
+
+	in := []reflect.Type{reflect.TypeFor[*testing.T]()}
+	for i := 3; i < injector.Type().NumIn(); i++ {
+		in = append(in, injector.Type().In(i))
+	}
+
+	fuzzType := reflect.FuncOf(in, nil, false)
+
+	return reflect.MakeFunc(fuzzType, func(args []reflect.Value) []reflect.Value {
+		t := asT[T](args[0].Interface().(*testing.T)) //nolint:forcetypeassert // guaranteed by fuzzType
+		t.Helper()
+
+		o := newOptions(ts.opts)
+
+		t, data, err := ts.prepare(t, index, noVariant, &o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		func() {
+			defer o.enter(t, fmt.Sprintf("arrange: fuzz input of '%s'", caseName))()
+
+			in := []reflect.Value{reflect.ValueOf(&t).Elem(), reflect.ValueOf(&data.SUT), reflect.ValueOf(&data.State)}
+
+			out := injector.Call(append(in, args[1:]...))
+			if len(out) == 1 && !out[0].IsNil() {
+				t.Fatalf("injector of case '%s' (#%d) failed: %v", caseName, index, out[0].Interface())
+			}
+		}()
+
+		defer o.enter(t, testPhase("assert", caseName))()
+
+		run(t, data)
+
+		return nil
+	}).Interface(), nil
+}
+
+// checkInjector reports whether inject has the signature func(t *testing.T, sut *SUT, state *STATE, args...) with at
+// least one fuzz argument and an optional error result
+func checkInjector[SUT any, STATE any, T testing.TB](inject any) error {
+	want := fmt.Sprintf("func(%s, %s, %s, args...) [error]",
+		reflect.TypeFor[T](), reflect.TypeFor[*SUT](), reflect.TypeFor[*STATE]())
+
+	injectorType := reflect.TypeOf(inject)
+	if injectorType == nil || injectorType.Kind() != reflect.Func {
+		return fmt.Errorf("%w: want %s, got %T", ErrInvalidInjector, want, inject)
+	}
+
+	switch {
+	case injectorType.IsVariadic(),
+		injectorType.NumIn() < 4,
+		injectorType.In(0) != reflect.TypeFor[T](),
+		injectorType.In(1) != reflect.TypeFor[*SUT](),
+		injectorType.In(2) != reflect.TypeFor[*STATE](),
+		injectorType.NumOut() > 1,
+		injectorType.NumOut() == 1 && injectorType.Out(0) != reflect.TypeFor[error]():
+		return fmt.Errorf("%w: want %s, got %s", ErrInvalidInjector, want, injectorType)
+	default:
+		return nil
+	}
+}
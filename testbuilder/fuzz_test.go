@@ -0,0 +1,105 @@
+package testbuilder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFuzzBuilder() *TestsBuilder[string, string, func(t *testing.T, sut string)] {
+	builder := &TestsBuilder[string, string, func(t *testing.T, sut string)]{}
+	builder.Register("authenticated").
+		WithStateBuilder(appendStep("auth;"))
+	builder.Register("looked up").
+		WithStateBuilder(appendStep("lookup;")).
+		WithAssertion(func(t *testing.T, sut string) {
+			t.Helper()
+
+			assert.True(t, strings.HasPrefix(sut, "auth;lookup;"))
+		})
+	builder.Register("other").
+		WithSpecificBuilder(func(t *testing.T, _ *string, _ *string) {
+			t.Helper()
+
+			t.Fatal("other cases are not built")
+		})
+
+	return builder
+}
+
+func FuzzTestsBuilder_Fuzz(f *testing.F) {
+	f.Add("payload", 1)
+	f.Add("", 0)
+
+	newFuzzBuilder().Fuzz(f, "looked up", func(t *testing.T, sut *string, _ *string, payload string, n int) {
+		t.Helper()
+
+		*sut += strings.Repeat(payload, min(max(n, 0), 3))
+	}, func(t *testing.T, data TestData[string, string, func(t *testing.T, sut string)]) {
+		t.Helper()
+
+		data.Assert(t, data.SUT)
+	})
+}
+
+func TestTestsBuilder_Fuzz_Errors(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, _ TestData[string, string, func(t *testing.T, sut string)]) {
+		t.Helper()
+	}
+
+	testCases := map[string]struct {
+		caseName string
+		inject   any
+		err      error
+	}{
+		"unknown case": {
+			caseName: "unknown",
+			inject:   func(*testing.T, *string, *string, string) {},
+			err:      ErrTestNotFound,
+		},
+		"nil injector": {
+			caseName: "looked up",
+			err:      ErrInvalidInjector,
+		},
+		"no fuzz arguments": {
+			caseName: "looked up",
+			inject:   func(*testing.T, *string, *string) {},
+			err:      ErrInvalidInjector,
+		},
+		"wrong SUT": {
+			caseName: "looked up",
+			inject:   func(*testing.T, *int, *string, string) {},
+			err:      ErrInvalidInjector,
+		},
+		"wrong result": {
+			caseName: "looked up",
+			inject:   func(*testing.T, *string, *string, string) bool { return false },
+			err:      ErrInvalidInjector,
+		},
+		"valid with error": {
+			caseName: "looked up",
+			inject:   func(*testing.T, *string, *string, []byte) error { return nil },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			fuzz, err := newFuzzBuilder().fuzzFunc(tc.caseName, tc.inject, run)
+
+			// Assert
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, func(*testing.T, []byte) {}, fuzz)
+		})
+	}
+}
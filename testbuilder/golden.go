@@ -0,0 +1,91 @@
+package testbuilder
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// UpdateEnv is the environment variable that makes GoldenSpec update the golden files, e.g. TESTBUILDER_UPDATE=1
+const UpdateEnv = "TESTBUILDER_UPDATE"
+
+// update makes GoldenSpec update the golden files, e.g. go test ./... -testbuilder.update
+var update = flag.Bool("testbuilder.update", false, "update the golden files of testbuilder.GoldenSpec")
+
+// GoldenSpec keeps the Spec next to the tests as testdata/<name>.spec.md, testdata/<name>.spec.json and the diagrams
+// testdata/<name>.spec.dot and testdata/<name>.spec.mmd, so changes to the covered scenarios show up in review. The
+// test fails when a file is missing or out of date. Run the tests with -testbuilder.update or TESTBUILDER_UPDATE=1 to
+// write the files, e.g.
+//
+//	func TestUserController_Spec(t *testing.T) {
+//		testbuilder.GoldenSpec(t, "user_controller", newBuilder().Spec())
+//	}
+func GoldenSpec(t testing.TB, name string, spec Spec) {
+	t.Helper()
+
+	data, err := spec.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden(t, filepath.Join("testdata", name+".spec.md"), []byte(spec.Markdown()))
+	golden(t, filepath.Join("testdata", name+".spec.json"), data)
+	golden(t, filepath.Join("testdata", name+".spec.dot"), []byte(spec.DOT()))
+	golden(t, filepath.Join("testdata", name+".spec.mmd"), []byte(spec.Mermaid()))
+}
+
+// golden compares content to the file at path, or writes it when updating
+func golden(t testing.TB, path string, content []byte) {
+	t.Helper()
+
+	if updating() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, content, 0o644); err != nil { //nolint:gosec // golden files are not secret
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("%v, run the tests with -testbuilder.update to create it", err)
+		return
+	}
+
+	if bytes.Equal(want, content) {
+		return
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(want)),
+		B:        difflib.SplitLines(string(content)),
+		FromFile: path,
+		ToFile:   "actual",
+		Context:  1,
+	})
+	if err != nil {
+		diff = err.Error()
+	}
+
+	t.Errorf("%s is out of date, run the tests with -testbuilder.update to update it:\n%s", path, diff)
+}
+
+// updating reports whether GoldenSpec updates the golden files
+func updating() bool {
+	if *update {
+		return true
+	}
+
+	enabled, err := strconv.ParseBool(os.Getenv(UpdateEnv))
+
+	return err == nil && enabled
+}
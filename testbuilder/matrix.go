@@ -0,0 +1,82 @@
+package testbuilder
+
+import (
+	"fmt"
+	"testing"
+)
+
+// maxVariantName is the number of runes of a string value that Vary keeps in the name of a variant
+const maxVariantName = 32
+
+// Matrix expands the TestCase into a subtest per variant, named "<TestName>/<variant Name>". Every variant shares the
+// inherited chain of the TestCase and runs as a Step after its SpecificBuilder. The variants do not affect further
+// tests. See Vary to create variants from a set of values.
+//
+// The value of a variant does not reach the SpecificBuilder itself, the function passed to Vary receives it instead.
+// Move the part of the SpecificBuilder that depends on the value into that function.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) Matrix(variants ...StepTB[SUT, STATE, T]) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Variants = append(ts.Variants, variants...)
+	return ts
+}
+
+// Vary creates a variant per value for TestCase.Matrix. The value reaches f with its type checked by the compiler, e.g.
+//
+//	builder.Register("invalid payload").
+//		Matrix(testbuilder.Vary(func(t *testing.T, _ *Sut, state *State, payload string) {
+//			state.payload = payload
+//		}, "", " ", strings.Repeat("a", 10_000))...)
+//
+// The variants are named after their value, strings are quoted and long strings are shortened.
+func Vary[SUT any, STATE any, V any, T testing.TB](
+	f func(t T, sut *SUT, state *STATE, value V),
+	values ...V,
+) []StepTB[SUT, STATE, T] {
+	variants := make([]StepTB[SUT, STATE, T], 0, len(values))
+
+	for _, value := range values {
+		variants = append(variants, NewStep(variantName(value), func(t T, sut *SUT, state *STATE) {
+			t.Helper()
+			f(t, sut, state, value)
+		}))
+	}
+
+	return variants
+}
+
+// BuildVariant builds the TestData of the TestCase at index like Build, followed by the variant at position variant of
+// its TestCase.Variants. A variant outside of the TestCase.Variants, including a negative one, returns
+// ErrIndexOutOfRange.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) BuildVariant(t T, index int, variant int) (TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	if variant < 0 {
+		return TestData[SUT, STATE, ASSERT]{}, fmt.Errorf("%w: got variant %d", ErrIndexOutOfRange, variant)
+	}
+
+	return ts.buildVariant(t, index, variant)
+}
+
+// variant returns the variant at position variant of the TestCase at index
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) variant(index int, variant int) (StepTB[SUT, STATE, T], error) {
+	variants := ts.TestCases[index].Variants
+	if variant < 0 || variant >= len(variants) {
+		return StepTB[SUT, STATE, T]{}, fmt.Errorf("%w: case '%s' (#%d) has %d variants, got variant %d",
+			ErrIndexOutOfRange, ts.TestCases[index].TestName, index, len(variants), variant)
+	}
+
+	return variants[variant], nil
+}
+
+// variantName is the name of a variant created by Vary for value
+func variantName(value any) string {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+
+	if runes := []rune(s); len(runes) > maxVariantName {
+		return fmt.Sprintf("%q...(%d bytes)", string(runes[:maxVariantName]), len(s))
+	}
+
+	return fmt.Sprintf("%q", s)
+}
@@ -0,0 +1,135 @@
+package testbuilder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVary(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	long := strings.Repeat("a", 100)
+
+	// Act
+	variants := Vary(func(t *testing.T, sut *string, _ *string, payload string) {
+		t.Helper()
+
+		*sut = payload
+	}, "", " ", long)
+
+	// Assert
+	require.Len(t, variants, 3)
+	assert.Equal(t, `""`, variants[0].Name)
+	assert.Equal(t, `" "`, variants[1].Name)
+	assert.Equal(t, `"`+strings.Repeat("a", 32)+`"...(100 bytes)`, variants[2].Name)
+
+	var sut string
+
+	require.NoError(t, variants[1].Func(t, &sut, nil))
+	assert.Equal(t, " ", sut)
+}
+
+func newMatrixBuilder() *TestsBuilder[string, string, func()] {
+	builder := &TestsBuilder[string, string, func()]{}
+	builder.Register("get user").
+		WithStateBuilder(appendStep("a"))
+	builder.Register("invalid payload").
+		WithSpecificBuilder(appendStep("-")).
+		Matrix(Vary(func(t *testing.T, sut *string, _ *string, n int) {
+			t.Helper()
+
+			*sut += strings.Repeat("x", n)
+		}, 1, 2)...)
+	builder.Register("success").
+		WithStateBuilder(appendStep("b"))
+
+	return builder
+}
+
+func TestTestsBuilder_BuildVariant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newMatrixBuilder()
+
+	// Act
+	first, firstErr := builder.BuildVariant(t, 1, 0)
+	second, secondErr := builder.BuildVariant(t, 1, 1)
+	base, baseErr := builder.Build(t, 1)
+	next, nextErr := builder.Build(t, 2)
+	_, outOfRangeErr := builder.BuildVariant(t, 1, 2)
+	_, negativeErr := builder.BuildVariant(t, 1, -1)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.NoError(t, baseErr)
+	require.NoError(t, nextErr)
+	assert.Equal(t, "a-x", first.SUT)
+	assert.Equal(t, "a-xx", second.SUT)
+	assert.Equal(t, "a-", base.SUT)
+	assert.Equal(t, "ab", next.SUT)
+	require.ErrorIs(t, outOfRangeErr, ErrIndexOutOfRange)
+	require.ErrorIs(t, negativeErr, ErrIndexOutOfRange)
+}
+
+func TestTestsBuilder_Tests_YieldsVariants(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newMatrixBuilder()
+	results := make(map[string]string)
+
+	// Act
+	for name, build := range builder.Tests() {
+		t.Run(name, func(t *testing.T) {
+			results[name] = build(t).SUT
+		})
+	}
+
+	// Assert
+	assert.Equal(t, map[string]string{
+		"get user":          "a",
+		"invalid payload/1": "a-x",
+		"invalid payload/2": "a-xx",
+		"success":           "ab",
+	}, results)
+}
+
+func TestTestsBuilder_Run_NestsVariants(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newMatrixBuilder()
+	results := make(map[string]string)
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		builder.Run(t, func(t *testing.T, data TestData[string, string, func()]) {
+			results[t.Name()] = data.SUT
+		})
+	})
+
+	// Assert
+	assert.Equal(t, map[string]string{
+		t.Name() + "/run/get_user":          "a",
+		t.Name() + "/run/invalid_payload/1": "a-x",
+		t.Name() + "/run/invalid_payload/2": "a-xx",
+		t.Name() + "/run/success":           "ab",
+	}, results)
+}
+
+func TestTestsBuilder_Validate_VariantsAreBuilders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("invalid payload").
+		Matrix(NewStep("empty", appendStep(""))).
+		WithAssertion(func() {})
+
+	// Act
+	err := builder.Validate()
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+package testbuilder
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+// Option configures how the TestCase's of a TestsBuilder are built and executed. Options are set on the TestsBuilder
+// with TestsBuilder.With or passed to TestsBuilder.Run.
+type Option func(*options)
+
+// options holds the configuration applied by the Option's
+type options struct {
+	// parallel calls t.Parallel in every subtest created by Run
+	parallel bool
+	// initial constructs the SUT and STATE, it is stored untyped so that Option does not need type parameters
+	initial any
+	// checkpoints builds the StateBuilder chain once and clones the SUT and STATE at every TestCase
+	checkpoints bool
+	// clone copies the SUT and STATE for checkpoints, when nil the Cloner implementations are used
+	clone any
+	// tracing logs every builder that is applied
+	tracing bool
+	// validate calls TestsBuilder.Validate before building
+	validate bool
+	// filters are tag filter expressions that a TestCase must all match to run
+	filters []string
+	// diffs snapshots the SUT and STATE after every builder, see Diffs
+	diffs bool
+	// snapshots are taken by the build that owns this copy of the options when diffs is enabled
+	snapshots *snapshots
+	// omit is the StateBuilder that Audit removes from the chain
+	omit *auditStep
+	// phases tracks the phase of the test that owns this copy of the options, see trackPhases
+	phases *phases
+}
+
+// newOptions applies the Option's in order to the configuration from the environment
+func newOptions(opts []Option) options {
+	var o options
+
+	if enabled, err := strconv.ParseBool(os.Getenv(TraceEnv)); err == nil {
+		o.tracing = enabled
+	}
+
+	if enabled, err := strconv.ParseBool(os.Getenv(DiffsEnv)); err == nil {
+		o.diffs = enabled
+	}
+
+	if expr := os.Getenv(TagsEnv); expr != "" {
+		o.filters = append(o.filters, expr)
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Parallel marks every subtest created by TestsBuilder.Run as parallel
+func Parallel() Option {
+	return func(o *options) {
+		o.parallel = true
+	}
+}
+
+// Initial constructs the SUT and STATE before any builder runs, instead of starting from their zero values. The SUT,
+// STATE and T must match the TestsBuilder the Option is applied to, otherwise building fails with ErrInvalidOption. See
+// TestsBuilder.WithInitial for the type-checked variant.
+func Initial[SUT any, STATE any, T testing.TB](f func(t T) (SUT, STATE)) Option {
+	return func(o *options) {
+		o.initial = f
+	}
+}
+
+// With stores the Option's on the TestsBuilder, they apply to TestsBuilder.Tests, TestsBuilder.Build and
+// TestsBuilder.Run
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) With(opts ...Option) *TestsBuilderTB[SUT, STATE, ASSERT, T] {
+	ts.opts = append(ts.opts, opts...)
+	// the checkpoints were built with the previous options, e.g. another Initial
+	ts.checkpoints = &checkpoints[SUT, STATE, ASSERT, T]{}
+
+	return ts
+}
+
+// WithInitial constructs the SUT and STATE before any builder runs in every TestCase, instead of starting from their
+// zero values
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) WithInitial(f func(t T) (SUT, STATE)) *TestsBuilderTB[SUT, STATE, ASSERT, T] {
+	return ts.With(Initial(f))
+}
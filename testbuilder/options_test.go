@@ -0,0 +1,68 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestsBuilder_WithInitial(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, []string, func()]{}
+	builder.WithInitial(func(t *testing.T) (string, []string) {
+		t.Helper()
+
+		return "sut", []string{"initial"}
+	})
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "state1")
+		})
+	builder.Register("2").
+		WithSpecificBuilder(func(t *testing.T, _ *string, state *[]string) {
+			t.Helper()
+
+			*state = append(*state, "specific2")
+		})
+
+	results := map[string][]string{
+		"1": {"initial", "state1"},
+		"2": {"initial", "state1", "specific2"},
+	}
+
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			testData := testBuilder(t)
+
+			// Assert
+			assert.Equal(t, "sut", testData.SUT)
+			assert.Equal(t, results[testName], testData.State)
+		})
+	}
+}
+
+func TestTestsBuilder_Build_InitialTypeMismatch(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.With(Initial(func(t *testing.T) (int, string) {
+		t.Helper()
+
+		return 1, "state"
+	}))
+	builder.Register("1")
+
+	// Act
+	_, err := builder.Build(t, 0)
+
+	// Assert
+	require.ErrorIs(t, err, ErrInvalidOption)
+	assert.EqualError(t, err,
+		"invalid option: Initial expects func(*testing.T) (string, string), got func(*testing.T) (int, string)")
+}
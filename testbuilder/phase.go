@@ -0,0 +1,146 @@
+package testbuilder
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// phases tracks the phase a test is in, e.g. "arrange: state builder of 'get user failure'" or "assert: 'success'"
+type phases struct {
+	mu      sync.Mutex
+	current string
+	// wrapped is set when the T of the test is a phaseTB, otherwise the phases in failed are logged when the test ends
+	wrapped bool
+	failed  []string
+}
+
+func (p *phases) set(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.current = phase
+}
+
+func (p *phases) prefix() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return "[" + p.current + "]"
+}
+
+// fail records that the test failed in the phase
+func (p *phases) fail(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failed = append(p.failed, phase)
+}
+
+// failedIn returns the phases the test failed in, in the order they were entered
+func (p *phases) failedIn() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.failed)
+}
+
+// phaseTB prefixes the failures of a test with the phase it is in when the failure is reported. As the phase is read at
+// that moment, a t that is stored during arrange (e.g. by gomock.NewController) reports a failure during the assert
+// with the assert phase.
+type phaseTB struct {
+	testing.TB
+
+	phases *phases
+}
+
+func (t *phaseTB) Error(args ...any) {
+	t.TB.Helper()
+	t.TB.Error(append([]any{t.phases.prefix()}, args...)...)
+}
+
+func (t *phaseTB) Errorf(format string, args ...any) {
+	t.TB.Helper()
+	t.TB.Errorf(t.phases.prefix()+" "+format, args...)
+}
+
+func (t *phaseTB) Fatal(args ...any) {
+	t.TB.Helper()
+	t.TB.Fatal(append([]any{t.phases.prefix()}, args...)...)
+}
+
+func (t *phaseTB) Fatalf(format string, args ...any) {
+	t.TB.Helper()
+	t.TB.Fatalf(t.phases.prefix()+" "+format, args...)
+}
+
+// trackPhases starts tracking the phases of a test in o. The returned T prefixes failures with the phase, which is only
+// possible when T is an interface like testing.TB. Other T, e.g. *testing.T, cannot be wrapped: t is returned as is and
+// the phases it failed in are logged when the test ends.
+func trackPhases[T testing.TB](t T, o *options) T {
+	t.Helper()
+
+	p := &phases{}
+	o.phases = p
+
+	if wrapped, ok := any(&phaseTB{TB: t, phases: p}).(T); ok {
+		p.wrapped = true
+
+		return wrapped
+	}
+
+	// registered before the builders run, so it runs after their cleanups. A cleanup that logs is reported at the call
+	// that registered it, i.e. the call of the caller to Build or Tests, instead of the deferred exit of a phase which
+	// may run inside runtime.Goexit.
+	t.Cleanup(func() {
+		t.Helper()
+
+		for _, phase := range p.failedIn() {
+			t.Logf("[%s] failed", phase)
+		}
+	})
+
+	return t
+}
+
+// enter the phase, the returned function records the phase when the test failed in it and its T is not a phaseTB
+func (o options) enter(t testing.TB, phase string) func() {
+	if o.phases == nil {
+		return func() {}
+	}
+
+	o.phases.set(phase)
+
+	if o.phases.wrapped {
+		return func() {}
+	}
+
+	failed := t.Failed()
+
+	return func() {
+		if !failed && t.Failed() {
+			o.phases.fail(phase)
+		}
+	}
+}
+
+// enterUntilCleanup enters the phase for the rest of the test, e.g. the assert phase after Build. When T is not a
+// phaseTB, a failure in the phase is recorded by a t.Cleanup, which runs before the cleanups registered while building.
+func (o options) enterUntilCleanup(t testing.TB, phase string) {
+	t.Cleanup(o.enter(t, phase))
+}
+
+// testPhase is the phase of a test after it is built, e.g. "assert: 'success'"
+func testPhase(phase string, testName string) string {
+	return fmt.Sprintf("%s: '%s'", phase, testName)
+}
+
+// arrangePhase is the phase of a builder, e.g. "arrange: state builder 'mock get user' of 'get user failure'"
+func arrangePhase(kind BuilderKind, step string, testName string) string {
+	if step != "" {
+		return fmt.Sprintf("arrange: %s '%s' of '%s'", kind, step, testName)
+	}
+
+	return fmt.Sprintf("arrange: %s of '%s'", kind, testName)
+}
@@ -0,0 +1,141 @@
+package testbuilder
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTB records failures and logs like testing.T formats them instead of reporting them, Fatal does not stop
+// the test
+type recordingTB struct {
+	testing.TB
+
+	errors   []string
+	logs     []string
+	cleanups []func()
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Failed() bool {
+	return len(r.errors) > 0
+}
+
+func (r *recordingTB) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+// cleanup runs the functions registered with Cleanup in reverse order, like testing.T when the test ends
+func (r *recordingTB) cleanup() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
+
+func (r *recordingTB) Error(args ...any) {
+	r.errors = append(r.errors, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fatal(args ...any) {
+	r.Error(args...)
+}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+}
+
+func (r *recordingTB) Logf(format string, args ...any) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+type phaseState struct {
+	t testing.TB
+}
+
+func TestPhases_Wrapped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := TestsBuilderTB[string, phaseState, func(), testing.TB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(t testing.TB, _ *string, state *phaseState) {
+			t.Errorf("boom %d", 1)
+
+			state.t = t
+		})
+	builder.Register("success").
+		WithStep("mock send mail", func(t testing.TB, _ *string, _ *phaseState) {
+			t.Fatal("boom")
+		})
+
+	// Act
+	data, err := builder.Build(recorder, 1)
+	data.State.t.Error("unexpected call")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"[arrange: state builder of 'get user failure'] boom 1",
+		"[arrange: state builder 'mock send mail' of 'success'] boom",
+		"[assert: 'success'] unexpected call",
+	}, recorder.errors)
+	assert.Empty(t, recorder.logs)
+}
+
+func TestPhases_Unwrapped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := TestsBuilderTB[string, string, func(), *recordingTB]{}
+	builder.Register("get user failure").
+		WithStateBuilder(func(t *recordingTB, _ *string, _ *string) {
+			t.Errorf("boom")
+		})
+	builder.Register("success").
+		WithStateBuilder(func(t *recordingTB, _ *string, _ *string) {
+			t.Errorf("boom again")
+		})
+
+	// Act
+	_, err := builder.Build(recorder, 1)
+	logged := slices.Clone(recorder.logs)
+	recorder.cleanup()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"boom", "boom again"}, recorder.errors)
+	assert.Empty(t, logged)
+	assert.Equal(t, []string{"[arrange: state builder of 'get user failure'] failed"}, recorder.logs)
+}
+
+func TestPhases_Unwrapped_Tests(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := TestsBuilderTB[string, string, func(), *recordingTB]{}
+	builder.Register("success")
+
+	// Act
+	for _, testBuilder := range builder.Tests() {
+		testBuilder(recorder)
+		recorder.Errorf("assertion broke")
+	}
+
+	recorder.cleanup()
+
+	// Assert
+	assert.Equal(t, []string{"assertion broke"}, recorder.errors)
+	assert.Equal(t, []string{"[assert: 'success'] failed"}, recorder.logs)
+}
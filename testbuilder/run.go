@@ -0,0 +1,98 @@
+package testbuilder
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// Run creates a subtest for every registered TestCase and removes the need to write the t.Run loop by hand. Inside
+// each subtest the TestData is built (see TestsBuilder.Tests) and passed to act. The act function exercises the SUT
+// and hands its output to TestData.Assert. A TestCase with Variants gets a nested subtest per variant. E.g.
+//
+//	builder.Run(t, func(t *testing.T, data testbuilder.TestData[Sut, State, Assert]) {
+//		user, err := data.SUT.Handle(data.State.userName, data.State.payload)
+//		data.Assert(t, data.SUT, data.State, user, err)
+//	}, testbuilder.Parallel())
+//
+// Run requires T to be implemented by *testing.T, e.g. *testing.T or testing.TB.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Run(
+	t *testing.T,
+	act func(t T, data TestData[SUT, STATE, ASSERT]),
+	opts ...Option,
+) {
+	t.Helper()
+
+	ts.runCases(t, opts, func(t T, o options, testName string, data TestData[SUT, STATE, ASSERT]) {
+		t.Helper()
+
+		defer o.enter(t, testPhase("assert", testName))()
+
+		act(t, data)
+	})
+}
+
+// runCases creates the subtests of Run and passes the TestData of each subtest to act
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) runCases(
+	t *testing.T,
+	opts []Option,
+	act func(t T, o options, testName string, data TestData[SUT, STATE, ASSERT]),
+) {
+	t.Helper()
+
+	o := newOptions(append(slices.Clone(ts.opts), opts...))
+	ts = ts.scoped()
+
+	for i, testcase := range ts.TestCases {
+		t.Run(testcase.TestName, func(t *testing.T) {
+			if o.parallel {
+				t.Parallel()
+			}
+
+			if len(testcase.Variants) == 0 {
+				ts.run(asT[T](t), i, noVariant, o, act)
+				return
+			}
+
+			for v, variant := range testcase.Variants {
+				t.Run(variant.Name, func(t *testing.T) {
+					if o.parallel {
+						t.Parallel()
+					}
+
+					ts.run(asT[T](t), i, v, o, act)
+				})
+			}
+		})
+	}
+}
+
+// run builds the TestCase at index and its variant and passes the TestData to act
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) run(
+	t T,
+	index int,
+	variant int,
+	o options,
+	act func(t T, o options, testName string, data TestData[SUT, STATE, ASSERT]),
+) {
+	t.Helper()
+
+	t, data, err := ts.prepare(t, index, variant, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act(t, o, ts.TestCases[index].TestName, data)
+}
+
+// asT converts the *testing.T or *testing.B of a subtest into T, the test fails when it does not implement T
+func asT[T testing.TB](t testing.TB) T {
+	t.Helper()
+
+	converted, ok := any(t).(T)
+	if !ok {
+		t.Fatalf("%v: %T does not implement %s", ErrInvalidOption, t, reflect.TypeFor[T]())
+	}
+
+	return converted
+}
@@ -0,0 +1,92 @@
+package testbuilder
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestsBuilder_Run(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, int, func(t *testing.T, out string)]{}
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, sut *string, state *int) {
+			t.Helper()
+
+			*sut += "a"
+			*state++
+		}).
+		WithAssertion(func(t *testing.T, out string) {
+			t.Helper()
+
+			assert.Equal(t, "a-1", out)
+		})
+	builder.Register("2").
+		WithStateBuilder(func(t *testing.T, sut *string, state *int) {
+			t.Helper()
+
+			*sut += "b"
+			*state++
+		}).
+		WithAssertion(func(t *testing.T, out string) {
+			t.Helper()
+
+			assert.Equal(t, "ab-2", out)
+		})
+
+	var (
+		mu   sync.Mutex
+		acts []string
+	)
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		builder.Run(t, func(t *testing.T, data TestData[string, int, func(t *testing.T, out string)]) {
+			mu.Lock()
+			acts = append(acts, t.Name())
+			mu.Unlock()
+
+			data.Assert(t, data.SUT+"-"+string(rune('0'+data.State)))
+		})
+	})
+
+	// Assert
+	assert.Equal(t, []string{"TestTestsBuilder_Run/run/1", "TestTestsBuilder_Run/run/2"}, acts)
+}
+
+func TestTestsBuilder_Run_Parallel(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("1")
+	builder.Register("2")
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		events = append(events, event)
+	}
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		builder.Run(t, func(t *testing.T, _ TestData[string, string, func()]) {
+			record(t.Name())
+		}, Parallel())
+
+		// parallel subtests only start after the parent returns
+		record("returned")
+	})
+
+	// Assert
+	require.Len(t, events, 3)
+	assert.Equal(t, "returned", events[0])
+}
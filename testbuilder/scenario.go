@@ -0,0 +1,60 @@
+package testbuilder
+
+import (
+	"testing"
+)
+
+// Assertion is the typed assertion of a Scenario. It receives the SUT and STATE that were passed to Scenario.Act and
+// the OUT that it returned
+type Assertion[SUT any, STATE any, OUT any] = AssertionTB[SUT, STATE, OUT, *testing.T]
+
+// AssertionTB is Assertion for any testing.TB, see TestsBuilderTB
+type AssertionTB[SUT any, STATE any, OUT any, T testing.TB] func(t T, sut SUT, state STATE, out OUT)
+
+// Scenario is a TestsBuilder with a single Act function that is shared by all TestCase's, which allows it to drive
+// arrange-act-assert end to end:
+// - Arrange: the SUT and STATE are built like TestsBuilder.Tests
+// - Act: Scenario.Act is called with the SUT and STATE
+// - Assert: the Assertion of the TestCase is called with the output of Act
+//
+// SUT methods with multiple return values can be mapped to a small result struct for OUT, e.g.
+//
+//	type Result struct {
+//		User *User
+//		Err  error
+//	}
+type Scenario[SUT any, STATE any, OUT any] = ScenarioTB[SUT, STATE, OUT, *testing.T]
+
+// ScenarioTB is Scenario for any testing.TB, see TestsBuilderTB
+type ScenarioTB[SUT any, STATE any, OUT any, T testing.TB] struct {
+	TestsBuilderTB[SUT, STATE, AssertionTB[SUT, STATE, OUT, T], T]
+
+	// Act exercises the SUT and returns its output
+	Act func(t T, sut SUT, state STATE) OUT
+}
+
+// Run creates a subtest for every registered TestCase which builds the TestData, calls Scenario.Act and passes its
+// output to the Assertion of the TestCase. A TestCase without Assertion only runs Act.
+func (s *ScenarioTB[SUT, STATE, OUT, T]) Run(t *testing.T, opts ...Option) {
+	t.Helper()
+
+	if s.Act == nil {
+		t.Fatal("testbuilder: Scenario.Act must be set before calling Run")
+	}
+
+	s.runCases(t, opts, func(t T, o options, testName string, data TestData[SUT, STATE, AssertionTB[SUT, STATE, OUT, T]]) {
+		t.Helper()
+
+		out := func() OUT {
+			defer o.enter(t, testPhase("act", testName))()
+
+			return s.Act(t, data.SUT, data.State)
+		}()
+
+		if data.Assert != nil {
+			defer o.enter(t, testPhase("assert", testName))()
+
+			data.Assert(t, data.SUT, data.State, out)
+		}
+	})
+}
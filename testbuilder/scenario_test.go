@@ -0,0 +1,65 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenario_Run(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	type result struct {
+		sum int
+	}
+
+	asserted := make([]string, 0)
+
+	scenario := Scenario[int, int, result]{
+		Act: func(t *testing.T, sut int, state int) result {
+			t.Helper()
+
+			return result{sum: sut + state}
+		},
+	}
+	scenario.Register("first").
+		WithStateBuilder(func(t *testing.T, sut *int, state *int) {
+			t.Helper()
+
+			*sut = 10
+			*state = 1
+		}).
+		WithAssertion(func(t *testing.T, sut int, state int, out result) {
+			t.Helper()
+
+			asserted = append(asserted, t.Name())
+
+			assert.Equal(t, 10, sut)
+			assert.Equal(t, 1, state)
+			assert.Equal(t, result{sum: 11}, out)
+		})
+	scenario.Register("second").
+		WithSpecificBuilder(func(t *testing.T, _ *int, state *int) {
+			t.Helper()
+
+			*state = 5
+		}).
+		WithAssertion(func(t *testing.T, sut int, state int, out result) {
+			t.Helper()
+
+			asserted = append(asserted, t.Name())
+
+			assert.Equal(t, 10, sut)
+			assert.Equal(t, 5, state)
+			assert.Equal(t, result{sum: 15}, out)
+		})
+	scenario.Register("without assertion")
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		scenario.Run(t)
+	})
+
+	// Assert
+	assert.Equal(t, []string{"TestScenario_Run/run/first", "TestScenario_Run/run/second"}, asserted)
+}
@@ -0,0 +1,169 @@
+package testbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Spec is a living specification of the registered TestCase's, see TestsBuilder.Spec
+type Spec struct {
+	Cases []SpecCase `json:"cases"`
+}
+
+// SpecCase describes a TestCase and the builders that arrange it
+type SpecCase struct {
+	// Index of the TestCase
+	Index int `json:"index"`
+	// Name is the TestName of the TestCase
+	Name string `json:"name"`
+	// Description of the TestCase, see TestCase.WithDescription
+	Description string `json:"description,omitempty"`
+	// Parent is the TestName of the TestCase it inherits from, empty for the first TestCase of a chain
+	Parent string `json:"parent,omitempty"`
+	// Steps are the inherited StateBuilder's in chain order, followed by the SpecificBuilder's of the TestCase
+	Steps []SpecStep `json:"steps"`
+	// Variants are the names of the variants, see TestCase.Matrix
+	Variants []string `json:"variants,omitempty"`
+	// Tags of the TestCase
+	Tags []string `json:"tags,omitempty"`
+	// Skip is the reason the TestCase is skipped
+	Skip string `json:"skip,omitempty"`
+	// Only is set when the TestCase is marked Only
+	Only bool `json:"only,omitempty"`
+	// Assertion is set when the TestCase has an Assertion
+	Assertion bool `json:"assertion"`
+	// Error is set when the chain of the TestCase cannot be resolved, e.g. on an unknown parent
+	Error string `json:"error,omitempty"`
+}
+
+// SpecStep is a builder that is applied while building a TestCase
+type SpecStep struct {
+	// Kind of the builder
+	Kind BuilderKind `json:"kind"`
+	// Case is the TestName of the TestCase that owns the builder
+	Case string `json:"case"`
+	// Index of the TestCase that owns the builder
+	Index int `json:"index"`
+	// Name of the Step, empty for the unnamed builders
+	Name string `json:"name,omitempty"`
+}
+
+// String is the label of the builder in the Trace output, e.g. "state[1] get user failure: mock get user"
+func (s SpecStep) String() string {
+	return stepLabel(s.Kind, s.Index, s.Case, s.Name)
+}
+
+// Spec exports the registered TestCase's with their descriptions and the builders that arrange them. Render it with
+// Spec.Markdown, Spec.JSON, Spec.DOT or Spec.Mermaid, or keep it next to the tests with GoldenSpec.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Spec() Spec {
+	spec := Spec{Cases: make([]SpecCase, 0, len(ts.TestCases))}
+
+	for i, testcase := range ts.TestCases {
+		specCase := SpecCase{
+			Index:       i,
+			Name:        testcase.TestName,
+			Description: testcase.Description,
+			Steps:       []SpecStep{},
+			Tags:        testcase.Tags,
+			Skip:        testcase.SkipReason,
+			Only:        testcase.Focused,
+			Assertion:   !isZero(testcase.Assertion),
+		}
+
+		for _, variant := range testcase.Variants {
+			specCase.Variants = append(specCase.Variants, variant.Name)
+		}
+
+		if parent, err := ts.parent(i); err == nil && parent >= 0 {
+			specCase.Parent = ts.TestCases[parent].TestName
+		}
+
+		chain, err := ts.chain(i)
+		if err != nil {
+			specCase.Error = err.Error()
+			spec.Cases = append(spec.Cases, specCase)
+
+			continue
+		}
+
+		for _, j := range chain {
+			specCase.Steps = append(specCase.Steps, ts.specSteps(KindState, j)...)
+		}
+
+		specCase.Steps = append(specCase.Steps, ts.specSteps(KindSpecific, i)...)
+		spec.Cases = append(spec.Cases, specCase)
+	}
+
+	return spec
+}
+
+// specSteps describes the builders of the given kind of the TestCase at owner
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) specSteps(kind BuilderKind, owner int) []SpecStep {
+	testcase := ts.TestCases[owner]
+	steps := testcase.steps(kind)
+
+	specSteps := make([]SpecStep, 0, len(steps))
+	for _, step := range steps {
+		specSteps = append(specSteps, SpecStep{Kind: kind, Case: testcase.TestName, Index: owner, Name: step.Name})
+	}
+
+	return specSteps
+}
+
+// JSON renders the Spec as indented JSON
+func (s Spec) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal spec: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// Markdown renders the Spec as a Markdown table with a row per TestCase
+func (s Spec) Markdown() string {
+	var b strings.Builder
+
+	b.WriteString("| # | Case | Description | Steps | Variants | Tags |\n")
+	b.WriteString("|---|------|-------------|-------|----------|------|\n")
+
+	for _, specCase := range s.Cases {
+		steps := make([]string, 0, len(specCase.Steps))
+		for _, step := range specCase.Steps {
+			steps = append(steps, step.String())
+		}
+
+		if specCase.Error != "" {
+			steps = append(steps, "error: "+specCase.Error)
+		}
+
+		fmt.Fprintf(&b, "| %d | %s | %s | %s | %s | %s |\n",
+			specCase.Index,
+			markdownCell(specCase.title()),
+			markdownCell(specCase.Description),
+			markdownCell(strings.Join(steps, "\n")),
+			markdownCell(strings.Join(specCase.Variants, "\n")),
+			markdownCell(strings.Join(specCase.Tags, ", ")),
+		)
+	}
+
+	return b.String()
+}
+
+// title is the name of the SpecCase, marked when it is skipped or focused
+func (c SpecCase) title() string {
+	switch {
+	case c.Skip != "":
+		return fmt.Sprintf("%s (skipped: %s)", c.Name, c.Skip)
+	case c.Only:
+		return c.Name + " (only)"
+	default:
+		return c.Name
+	}
+}
+
+// markdownCell escapes text for a Markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\n", "<br>").Replace(text)
+}
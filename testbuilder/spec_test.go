@@ -0,0 +1,147 @@
+package testbuilder
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSpecBuilder() *TestsBuilder[string, string, func()] {
+	builder := &TestsBuilder[string, string, func()]{}
+	builder.Register("invalid payload").
+		WithDescription("an empty payload is rejected").
+		WithSpecificBuilder(appendStep("")).
+		Matrix(Vary(func(t *testing.T, _ *string, state *string, payload string) {
+			t.Helper()
+
+			*state = payload
+		}, "", " ")...)
+	builder.Register("get user failure").
+		WithDescription("the user | lookup fails").
+		WithStateBuilder(appendStep("a")).
+		WithTags("db")
+	builder.Register("send mail failure").
+		WithStep("GetUser returns user", appendStep("b")).
+		WithSpecificStep("SendMail fails", appendStep("c")).
+		Skip("flaky")
+	builder.Register("admin").
+		From("get user failure").
+		WithStateBuilder(appendStep("d")).
+		WithAssertion(func() {})
+	builder.Register("unknown").
+		From("nobody")
+
+	return builder
+}
+
+func TestTestsBuilder_Spec(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newSpecBuilder()
+
+	// Act
+	spec := builder.Spec()
+
+	// Assert
+	require.Len(t, spec.Cases, 5)
+	assert.Equal(t, "an empty payload is rejected", spec.Cases[0].Description)
+	assert.Equal(t, []string{`""`, `" "`}, spec.Cases[0].Variants)
+	assert.Equal(t, "get user failure", spec.Cases[2].Parent)
+	assert.True(t, spec.Cases[3].Assertion)
+	assert.False(t, spec.Cases[2].Assertion)
+	assert.Equal(t, []SpecStep{
+		{Kind: KindState, Case: "get user failure", Index: 1},
+		{Kind: KindState, Case: "send mail failure", Index: 2, Name: "GetUser returns user"},
+		{Kind: KindSpecific, Case: "send mail failure", Index: 2, Name: "SendMail fails"},
+	}, spec.Cases[2].Steps)
+	assert.Equal(t, []SpecStep{
+		{Kind: KindState, Case: "get user failure", Index: 1},
+		{Kind: KindState, Case: "admin", Index: 3},
+	}, spec.Cases[3].Steps)
+	require.ErrorIs(t, builder.Validate(), ErrUnknownParent)
+	assert.Contains(t, spec.Cases[4].Error, ErrUnknownParent.Error())
+}
+
+func TestSpec_Markdown(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	spec := newSpecBuilder().Spec()
+
+	// Act
+	markdown := spec.Markdown()
+
+	// Assert
+	assert.Equal(t, "| # | Case | Description | Steps | Variants | Tags |\n"+
+		"|---|------|-------------|-------|----------|------|\n"+
+		`| 0 | invalid payload | an empty payload is rejected | specific[0] invalid payload | ""<br>" " |  |`+"\n"+
+		`| 1 | get user failure | the user \| lookup fails | state[1] get user failure |  | db |`+"\n"+
+		"| 2 | send mail failure (skipped: flaky) |  | state[1] get user failure<br>"+
+		"state[2] send mail failure: GetUser returns user<br>specific[2] send mail failure: SendMail fails |  |  |\n"+
+		"| 3 | admin |  | state[1] get user failure<br>state[3] admin |  |  |\n"+
+		`| 4 | unknown |  | error: unknown parent: case "unknown" (#4) inherits from "nobody" |  |  |`+"\n",
+		markdown)
+}
+
+func TestSpec_JSON(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	spec := newSpecBuilder().Spec()
+
+	// Act
+	data, err := spec.JSON()
+
+	// Assert
+	require.NoError(t, err)
+
+	var decoded Spec
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, spec, decoded)
+}
+
+func TestGoldenSpec(t *testing.T) {
+	t.Parallel()
+
+	GoldenSpec(t, "spec", newSpecBuilder().Spec())
+}
+
+func TestGoldenSpec_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	if updating() {
+		t.Skip("golden files are being updated")
+	}
+
+	testCases := map[string]struct {
+		name     string
+		spec     Spec
+		contains string
+	}{
+		"missing": {
+			name:     "missing",
+			spec:     newSpecBuilder().Spec(),
+			contains: "run the tests with -testbuilder.update to create it",
+		},
+		"out of date": {
+			name:     "spec",
+			spec:     Spec{},
+			contains: "testdata/spec.spec.md is out of date",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			recorder := &recordingTB{}
+
+			// Act
+			GoldenSpec(recorder, tc.name, tc.spec)
+
+			// Assert
+			require.NotEmpty(t, recorder.errors)
+			assert.Contains(t, recorder.errors[0], tc.contains)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package testbuilder
+
+import (
+	"testing"
+)
+
+// Step is a named builder. The Steps of a TestCase run in the order they are added, together with the builders of
+// WithStateBuilder and WithSpecificBuilder, and are named in the Trace output and in a BuildError. A Step is a plain
+// value, so a library of Steps for a SUT can be shared between TestsBuilder's and table tests, e.g.
+//
+//	var getUserReturnsUser = testbuilder.NewStep("GetUser returns user", func(t *testing.T, _ *Sut, state *State) {
+//		state.mocks.MockRepository.EXPECT().GetUser(state.userName).Return(state.user, nil)
+//	})
+type Step[SUT any, STATE any] = StepTB[SUT, STATE, *testing.T]
+
+// StepTB is Step for any testing.TB, see TestsBuilderTB
+type StepTB[SUT any, STATE any, T testing.TB] struct {
+	// Name of the Step, e.g. "mock get user"
+	Name string
+	// Func mutates the SUT and STATE, a returned error fails the build with a BuildError
+	Func func(t T, sut *SUT, state *STATE) error
+}
+
+// NewStep names a builder, see Step
+func NewStep[SUT any, STATE any, T testing.TB](name string, f func(t T, sut *SUT, state *STATE)) StepTB[SUT, STATE, T] {
+	return StepTB[SUT, STATE, T]{Name: name, Func: ignoreError(f)}
+}
+
+// NewStepE names an error-returning builder, see Step
+func NewStepE[SUT any, STATE any, T testing.TB](name string, f func(t T, sut *SUT, state *STATE) error) StepTB[SUT, STATE, T] {
+	return StepTB[SUT, STATE, T]{Name: name, Func: f}
+}
+
+// Builder adapts the Step to a plain builder, e.g. for the StateBuilder field. A returned error fails the test with
+// t.Fatal and the name of the Step. Prefer WithSteps, which keeps the name in the Trace output and the BuildError.
+func (s StepTB[SUT, STATE, T]) Builder() func(t T, sut *SUT, state *STATE) {
+	return func(t T, sut *SUT, state *STATE) {
+		t.Helper()
+
+		if err := s.Func(t, sut, state); err != nil {
+			t.Fatalf("step '%s' failed: %v", s.Name, err)
+		}
+	}
+}
+
+// WithSteps adds the Steps that mutate the SUT and STATE for the current and all further tests. Steps run in the order
+// they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithSteps(steps ...StepTB[SUT, STATE, T]) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.StateSteps = append(ts.StateSteps, steps...)
+	return ts
+}
+
+// WithSpecificSteps adds the Steps that mutate the SUT and STATE only for this particular test. Steps run in the order
+// they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithSpecificSteps(steps ...StepTB[SUT, STATE, T]) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.SpecificSteps = append(ts.SpecificSteps, steps...)
+	return ts
+}
+
+// WithStep adds a named step that mutates the SUT and STATE for the current and all further tests. Steps run in the
+// order they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithStep(
+	name string,
+	f func(t T, sut *SUT, state *STATE),
+) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	return ts.WithSteps(NewStep(name, f))
+}
+
+// WithSpecificStep adds a named step that mutates the SUT and STATE only for this particular test. Steps run in the
+// order they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithSpecificStep(
+	name string,
+	f func(t T, sut *SUT, state *STATE),
+) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	return ts.WithSpecificSteps(NewStep(name, f))
+}
+
+// steps returns the builders of the given kind in the order they run: the StateBuilder or SpecificBuilder field and the
+// Steps in the order they are added
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) steps(kind BuilderKind) []StepTB[SUT, STATE, T] {
+	builder, steps := ts.StateBuilder, ts.StateSteps
+	if kind == KindSpecific {
+		builder, steps = ts.SpecificBuilder, ts.SpecificSteps
+	}
+
+	all := make([]StepTB[SUT, STATE, T], 0, len(steps)+1)
+	if builder != nil {
+		all = append(all, StepTB[SUT, STATE, T]{Func: ignoreError(builder)})
+	}
+
+	for _, step := range steps {
+		if step.Func != nil {
+			all = append(all, step)
+		}
+	}
+
+	return all
+}
+
+// hasBuilders reports whether the TestCase has any builder of the given kind
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) hasBuilders(kind BuilderKind) bool {
+	return len(ts.steps(kind)) > 0
+}
+
+// ignoreError adapts a builder to the error-returning signature of Step.Func
+func ignoreError[SUT any, STATE any, T testing.TB](
+	f func(t T, sut *SUT, state *STATE),
+) func(t T, sut *SUT, state *STATE) error {
+	return func(t T, sut *SUT, state *STATE) error {
+		t.Helper()
+		f(t, sut, state)
+
+		return nil
+	}
+}
+
+// unnamed adapts builders to Steps without a name, nil builders are left out
+func unnamed[SUT any, STATE any, T testing.TB](fs []func(t T, sut *SUT, state *STATE)) []StepTB[SUT, STATE, T] {
+	steps := make([]StepTB[SUT, STATE, T], 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			steps = append(steps, NewStep("", f))
+		}
+	}
+
+	return steps
+}
+
+// unnamedE adapts error-returning builders to Steps without a name, nil builders are left out
+func unnamedE[SUT any, STATE any, T testing.TB](fs []func(t T, sut *SUT, state *STATE) error) []StepTB[SUT, STATE, T] {
+	steps := make([]StepTB[SUT, STATE, T], 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			steps = append(steps, NewStepE("", f))
+		}
+	}
+
+	return steps
+}
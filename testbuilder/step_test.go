@@ -0,0 +1,213 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appendStep returns a builder that appends name to the SUT
+func appendStep(name string) func(t *testing.T, sut *string, state *string) {
+	return func(t *testing.T, sut *string, _ *string) {
+		t.Helper()
+
+		*sut += name
+	}
+}
+
+func TestTestCase_WithStateBuilder_Accumulates(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("a").
+		WithStateBuilder(appendStep("a"), appendStep("b")).
+		WithStateBuilder(appendStep("c")).
+		WithSpecificBuilder(appendStep("d")).
+		WithSpecificBuilder(appendStep("e"), nil)
+
+	// Act
+	data, err := builder.Build(t, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "abcde", data.SUT)
+}
+
+func TestTestCase_WithBuilderE_StopsAtFirstError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var calls int
+
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("a").
+		WithStateBuilderE(func(t *testing.T, _ *string, _ *string) error {
+			t.Helper()
+
+			return assert.AnError
+		}).
+		WithStateBuilderE(func(t *testing.T, _ *string, _ *string) error {
+			t.Helper()
+
+			calls++
+
+			return nil
+		})
+
+	// Act
+	_, err := builder.Build(t, 0)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Zero(t, calls)
+}
+
+func TestTestCase_WithStep(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("a").
+		WithStep("mock a", appendStep("a")).
+		WithSpecificStep("mock specific", appendStep("!"))
+	builder.Register("b").
+		WithStateBuilder(appendStep("b")).
+		WithStep("mock c", appendStep("c")).
+		WithStep("mock d", appendStep("d"))
+
+	// Act
+	data, err := builder.Build(t, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", data.SUT)
+	require.Len(t, builder.TestCases[1].StateSteps, 3)
+	assert.Equal(t, "mock c", builder.TestCases[1].StateSteps[1].Name)
+	require.Len(t, builder.TestCases[0].SpecificSteps, 1)
+	assert.Equal(t, "mock specific", builder.TestCases[0].SpecificSteps[0].Name)
+}
+
+func TestTestCase_WithStep_KeepsCallOrder(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("get user").
+		WithStep("first", appendStep("a")).
+		WithStateBuilder(appendStep("b")).
+		WithStateBuilderE(func(t *testing.T, sut *string, _ *string) error {
+			t.Helper()
+
+			*sut += "c"
+
+			return nil
+		}).
+		WithSteps(NewStep("fourth", appendStep("d"))).
+		WithSpecificBuilder(appendStep("-")).
+		WithSpecificStep("specific", appendStep("!"))
+
+	// Act
+	data, err := builder.Build(t, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "abcd-!", data.SUT)
+}
+
+func TestTestCase_WithStep_ErrorNamesStep(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("get user failure").
+		WithStep("mock get user", func(t *testing.T, _ *string, _ *string) {
+			t.Helper()
+
+			panic(assert.AnError)
+		})
+	builder.Register("success")
+
+	// Act
+	_, err := builder.Build(t, 1)
+
+	// Assert
+	var buildErr *BuildError
+	require.ErrorAs(t, err, &buildErr)
+	assert.Equal(t, "mock get user", buildErr.Step)
+	assert.EqualError(t, err, "state builder 'mock get user' of case 'get user failure' (#0) panicked while "+
+		"building case 'success' (#1): "+assert.AnError.Error())
+}
+
+func TestTestCase_WithStep_Trace(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := TestsBuilderTB[string, string, func(), *recordingTB]{}
+	builder.With(Trace())
+	builder.Register("get user failure").
+		WithStateBuilder(func(*recordingTB, *string, *string) {}).
+		WithStep("mock get user", func(*recordingTB, *string, *string) {}).
+		WithSpecificStep("mock send mail", func(*recordingTB, *string, *string) {})
+
+	// Act
+	_, err := builder.Build(recorder, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"state[0] get user failure",
+		"state[0] get user failure: mock get user",
+		"specific[0] get user failure: mock send mail",
+	}, traced(recorder))
+}
+
+func TestNewStep_SharedAcrossBuilders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	stepA := NewStep("append a", appendStep("a"))
+	stepB := NewStepE("append b", func(t *testing.T, sut *string, _ *string) error {
+		t.Helper()
+
+		*sut += "b"
+
+		return nil
+	})
+
+	first := TestsBuilder[string, string, func()]{}
+	first.Register("first").WithSteps(stepA, stepB)
+
+	second := TestsBuilder[string, string, func()]{}
+	second.Register("second").
+		WithStateBuilder(stepB.Builder()).
+		WithSpecificSteps(stepA)
+
+	// Act
+	firstData, firstErr := first.Build(t, 0)
+	secondData, secondErr := second.Build(t, 0)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, "ab", firstData.SUT)
+	assert.Equal(t, "ba", secondData.SUT)
+	assert.Equal(t, "append a", first.TestCases[0].StateSteps[0].Name)
+	assert.Equal(t, "append a", second.TestCases[0].SpecificSteps[0].Name)
+}
+
+func TestNewStepE_ErrorNamesStep(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	step := NewStepE("GetUser returns user", func(t *testing.T, _ *string, _ *string) error {
+		t.Helper()
+
+		return assert.AnError
+	})
+
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("get user").WithSpecificSteps(step)
+
+	// Act
+	_, err := builder.Build(t, 0)
+
+	// Assert
+	assert.EqualError(t, err, "specific builder 'GetUser returns user' of case 'get user' (#0) failed while "+
+		"building case 'get user' (#0): "+assert.AnError.Error())
+}
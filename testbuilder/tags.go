@@ -0,0 +1,53 @@
+package testbuilder
+
+import (
+	"slices"
+	"strings"
+)
+
+// TagsEnv is the environment variable with a tag filter expression that applies to every TestsBuilder, e.g.
+// TESTBUILDER_TAGS=!slow. See Filter.
+const TagsEnv = "TESTBUILDER_TAGS"
+
+// Filter only runs the TestCase's that match the tag filter expression, the others are skipped while their
+// StateBuilder's are still inherited. The expression is a comma separated list of tags:
+// - tag: the TestCase must have at least one of these tags
+// - !tag: the TestCase must have none of these tags
+//
+// E.g. "db,!slow" runs the TestCase's tagged with db that are not tagged with slow. Combined with TESTBUILDER_TAGS,
+// a TestCase must match both expressions. See TestCase.WithTags.
+func Filter(expr string) Option {
+	return func(o *options) {
+		o.filters = append(o.filters, expr)
+	}
+}
+
+// WithTags adds tags to the TestCase that can be selected with Filter or TESTBUILDER_TAGS
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithTags(tags ...string) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Tags = append(ts.Tags, tags...)
+	return ts
+}
+
+// matchTags reports whether tags match the tag filter expression, see Filter
+func matchTags(expr string, tags []string) bool {
+	included := false
+	hasIncludes := false
+
+	for term := range strings.SplitSeq(expr, ",") {
+		term = strings.TrimSpace(term)
+
+		switch {
+		case term == "" || term == "!":
+			continue
+		case strings.HasPrefix(term, "!"):
+			if slices.Contains(tags, strings.TrimSpace(term[1:])) {
+				return false
+			}
+		default:
+			hasIncludes = true
+			included = included || slices.Contains(tags, term)
+		}
+	}
+
+	return !hasIncludes || included
+}
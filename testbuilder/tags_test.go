@@ -0,0 +1,121 @@
+package testbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expr     string
+		tags     []string
+		expected bool
+	}{
+		"empty expression":          {expr: "", tags: []string{"slow"}, expected: true},
+		"excluded tag":              {expr: "!slow", tags: []string{"db", "slow"}, expected: false},
+		"not excluded":              {expr: "!slow", tags: []string{"db"}, expected: true},
+		"untagged not excluded":     {expr: "!slow", expected: true},
+		"included tag":              {expr: "db", tags: []string{"db"}, expected: true},
+		"not included":              {expr: "db", tags: []string{"slow"}, expected: false},
+		"untagged not included":     {expr: "db", expected: false},
+		"any included tag":          {expr: "db, http", tags: []string{"http"}, expected: true},
+		"included and excluded":     {expr: "db,!slow", tags: []string{"db", "slow"}, expected: false},
+		"included and not excluded": {expr: "db,!slow", tags: []string{"db"}, expected: true},
+		"empty terms are ignored":   {expr: " , !", tags: []string{"db"}, expected: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, matchTags(tt.expr, tt.tags))
+		})
+	}
+}
+
+func TestTestCase_WithTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	testcase := &TestCase[string, string, func()]{}
+
+	// Act
+	res := testcase.WithTags("slow").WithTags("db", "http")
+
+	// Assert
+	assert.Equal(t, testcase, res) // pointer equal
+	assert.Equal(t, []string{"slow", "db", "http"}, testcase.Tags)
+}
+
+func newTaggedBuilder() *TestsBuilder[string, int, func()] {
+	builder := &TestsBuilder[string, int, func()]{}
+	builder.Register("fast").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut = "a"
+		})
+	builder.Register("slow").
+		WithTags("slow").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "b"
+		})
+	builder.Register("db").
+		WithTags("db").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "c"
+		})
+
+	return builder
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newTaggedBuilder().With(Filter("!slow"))
+
+	results := make(map[string]string)
+	skipped := make(map[string]bool)
+
+	// Act
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			t.Cleanup(func() {
+				skipped[testName] = t.Skipped()
+			})
+
+			results[testName] = testBuilder(t).SUT
+		})
+	}
+
+	// Assert
+	assert.Equal(t, map[string]string{"fast": "a", "db": "abc"}, results)
+	assert.Equal(t, map[string]bool{"fast": false, "slow": true, "db": false}, skipped)
+}
+
+func TestFilter_Env(t *testing.T) {
+	// Arrange
+	t.Setenv(TagsEnv, "db")
+
+	builder := newTaggedBuilder().With(Filter("!slow"))
+
+	skipped := make(map[string]bool)
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		builder.Run(t, func(t *testing.T, _ TestData[string, int, func()]) {
+			t.Cleanup(func() {
+				skipped[t.Name()] = t.Skipped()
+			})
+		})
+	})
+
+	// Assert
+	assert.Equal(t, map[string]bool{"TestFilter_Env/run/db": false}, skipped)
+}
@@ -0,0 +1,91 @@
+package testbuilder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTBBuilder() *TestsBuilderTB[string, string, func(t testing.TB, sut string), testing.TB] {
+	builder := &TestsBuilderTB[string, string, func(t testing.TB, sut string), testing.TB]{}
+	builder.WithInitial(func(t testing.TB) (string, string) {
+		t.Helper()
+
+		return "initial;", ""
+	})
+	builder.Register("a").
+		WithStateBuilder(func(t testing.TB, sut *string, _ *string) {
+			t.Helper()
+
+			*sut += "a;"
+		}).
+		WithSteps(NewStep("b", func(t testing.TB, sut *string, _ *string) {
+			t.Helper()
+
+			*sut += "b;"
+		})).
+		WithAssertion(func(t testing.TB, sut string) {
+			t.Helper()
+
+			assert.Equal(t, "initial;a;b;", sut)
+		})
+
+	return builder
+}
+
+func TestTestsBuilderTB_Build(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newTBBuilder()
+
+	// Act
+	data, err := builder.Build(t, 0)
+
+	// Assert
+	require.NoError(t, err)
+	data.Assert(t, data.SUT)
+}
+
+func TestTestsBuilderTB_Run(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := newTBBuilder()
+	called := false
+
+	// Act
+	builder.Run(t, func(t testing.TB, data TestData[string, string, func(t testing.TB, sut string)]) {
+		called = true
+
+		data.Assert(t, data.SUT)
+	})
+
+	// Assert
+	assert.True(t, called)
+}
+
+func TestAsT(t *testing.T) {
+	t.Parallel()
+	// Act
+	converted := asT[testing.TB](t)
+
+	// Assert
+	assert.Equal(t, testing.TB(t), converted)
+}
+
+func BenchmarkTestsBuilderTB_Benchmark(b *testing.B) {
+	builder := newTBBuilder()
+	builder.Register("variants").
+		Matrix(Vary(func(t testing.TB, sut *string, _ *string, n int) {
+			t.Helper()
+
+			*sut += strings.Repeat("x", n)
+		}, 1, 2)...)
+
+	Benchmark(b, builder, func(b testing.TB, data TestData[string, string, func(t testing.TB, sut string)]) {
+		b.Helper()
+
+		_ = strings.ToUpper(data.SUT)
+	})
+}
@@ -1,8 +1,13 @@
 package testbuilder
 
 import (
+	"fmt"
 	"iter"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestsBuilder manages a collection of test cases for a system under test (SUT).
@@ -19,8 +24,22 @@ import (
 // - Third TestCase: TestCase[2].SpecificBuilder(TestCase[0..2].StateBuilder(SUT, STATE))
 // - ...
 // - Nth TestCase: TestCase[n].SpecificBuilder(TestCase[0..n].StateBuilder(SUT, STATE))
-type TestsBuilder[SUT any, STATE any, ASSERT any] struct {
-	TestCases []*TestCase[SUT, STATE, ASSERT]
+//
+// The linear chain above is the default where every TestCase inherits from the TestCase registered before it. Use
+// TestCase.From to inherit from a named TestCase instead, which turns the chain into a tree. See TestsBuilder.Tests.
+type TestsBuilder[SUT any, STATE any, ASSERT any] = TestsBuilderTB[SUT, STATE, ASSERT, *testing.T]
+
+// TestsBuilderTB is a TestsBuilder whose builders receive T instead of *testing.T. Use testing.TB to share the same
+// builders between tests and benchmarks. TestsBuilder.Run and TestsBuilder.Fuzz create subtests of a *testing.T and
+// therefore require T to be implemented by *testing.T.
+type TestsBuilderTB[SUT any, STATE any, ASSERT any, T testing.TB] struct {
+	TestCases []*TestCaseTB[SUT, STATE, ASSERT, T]
+
+	// opts are set by TestsBuilder.With
+	opts []Option
+
+	// checkpoints are set by TestsBuilder.With, see Checkpoints
+	checkpoints *checkpoints[SUT, STATE, ASSERT, T]
 }
 
 // TestData defines a generic structure for test data, including the system under test, state, and assertion logic.
@@ -40,40 +59,122 @@ type TestData[SUT any, STATE any, ASSERT any] struct {
 }
 
 // TestCase is yielded to the TestsBuilder.Tests range loop. See TestsBuilder for documentation on the types
-type TestCase[SUT any, STATE any, ASSERT any] struct {
+type TestCase[SUT any, STATE any, ASSERT any] = TestCaseTB[SUT, STATE, ASSERT, *testing.T]
+
+// TestCaseTB is TestCase for any testing.TB, see TestsBuilderTB
+type TestCaseTB[SUT any, STATE any, ASSERT any, T testing.TB] struct {
 	// TestName for the test case
 	TestName string
+	// Description of the scenario for readers of the Spec
+	Description string
+	// Parent is the TestName of the TestCase to inherit the StateBuilder chain from. When empty, the TestCase inherits
+	// from the TestCase registered before it.
+	Parent string
 	// StateBuilder that is subsequently used to build up state for the tests. The distinction between the StateBuilder
 	// and the SpecificBuilder is that StateBuilder is subsequently called for all TestCase's that are registered to the
-	// TestsBuilder.
-	StateBuilder func(t *testing.T, sut *SUT, state *STATE)
-	// SpecificBuilder is only run for this case
-	SpecificBuilder func(t *testing.T, sut *SUT, state *STATE)
+	// TestsBuilder. It is only set by a struct literal and runs before the StateSteps, WithStateBuilder adds to the
+	// StateSteps instead.
+	StateBuilder func(t T, sut *SUT, state *STATE)
+	// SpecificBuilder is only run for this case. It is only set by a struct literal and runs before the SpecificSteps,
+	// WithSpecificBuilder adds to the SpecificSteps instead.
+	SpecificBuilder func(t T, sut *SUT, state *STATE)
+	// StateSteps are the builders added with WithStateBuilder, WithStateBuilderE and WithSteps in the order they are
+	// added. They are inherited like the StateBuilder.
+	StateSteps []StepTB[SUT, STATE, T]
+	// SpecificSteps are the builders added with WithSpecificBuilder, WithSpecificBuilderE and WithSpecificSteps in the
+	// order they are added. They only run for this case.
+	SpecificSteps []StepTB[SUT, STATE, T]
+	// Variants expand the case into a subtest per variant, see TestCase.Matrix
+	Variants []StepTB[SUT, STATE, T]
+	// Teardown releases what the StateBuilder set up. It is registered with t.Cleanup for this case and all cases that
+	// inherit its StateBuilder, which makes the teardowns run in reverse chain order. It cannot be combined with
+	// Checkpoints.
+	Teardown func(t T, sut *SUT, state *STATE)
 	// Assertion logic
 	Assertion ASSERT
+	// SkipReason skips this case when not empty, its StateBuilder is still inherited by further cases
+	SkipReason string
+	// Focused skips all cases that are not Focused when at least one case is
+	Focused bool
+	// Tags select the case with Filter or TESTBUILDER_TAGS
+	Tags []string
+}
+
+// From inherits the StateBuilder chain of the TestCase named parent instead of the TestCase registered before this one
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) From(parent string) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Parent = parent
+	return ts
+}
+
+// WithStateBuilder mutates the SUT and STATE for the current and all further tests. The builders are added to the
+// StateSteps, so repeated calls accumulate and run in the order they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithStateBuilder(fs ...func(t T, sut *SUT, state *STATE)) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.StateSteps = append(ts.StateSteps, unnamed(fs)...)
+	return ts
 }
 
-// WithStateBuilder mutates the SUT and STATE for the current and all further tests
-func (ts *TestCase[SUT, STATE, ASSERT]) WithStateBuilder(f func(t *testing.T, sut *SUT, state *STATE)) *TestCase[SUT, STATE, ASSERT] {
-	ts.StateBuilder = f
+// WithSpecificBuilder mutates the SUT and STATE only for this particular test. The builders are added to the
+// SpecificSteps, so repeated calls accumulate and run in the order they are added.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithSpecificBuilder(fs ...func(t T, sut *SUT, state *STATE)) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.SpecificSteps = append(ts.SpecificSteps, unnamed(fs)...)
 	return ts
 }
 
-// WithSpecificBuilder mutates the SUT and STATE only for this particular test
-func (ts *TestCase[SUT, STATE, ASSERT]) WithSpecificBuilder(f func(t *testing.T, sut *SUT, state *STATE)) *TestCase[SUT, STATE, ASSERT] {
-	ts.SpecificBuilder = f
+// WithStateBuilderE mutates the SUT and STATE for the current and all further tests, a returned error fails the test
+// that is being built with a BuildError. The builders accumulate like WithStateBuilder, the first returned error stops
+// the remaining builders.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithStateBuilderE(fs ...func(t T, sut *SUT, state *STATE) error) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.StateSteps = append(ts.StateSteps, unnamedE(fs)...)
+	return ts
+}
+
+// WithSpecificBuilderE mutates the SUT and STATE only for this particular test, a returned error fails the test with
+// a BuildError. The builders accumulate like WithSpecificBuilder.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithSpecificBuilderE(fs ...func(t T, sut *SUT, state *STATE) error) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.SpecificSteps = append(ts.SpecificSteps, unnamedE(fs)...)
+	return ts
+}
+
+// WithTeardown releases what the StateBuilder set up for the current and all further tests, see TestCase.Teardown
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithTeardown(f func(t T, sut *SUT, state *STATE)) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Teardown = f
+	return ts
+}
+
+// WithDescription describes the scenario for readers of the Spec
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithDescription(description string) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Description = description
 	return ts
 }
 
 // WithAssertion holds any assertion logic associated with this TestCase
-func (ts *TestCase[SUT, STATE, ASSERT]) WithAssertion(f ASSERT) *TestCase[SUT, STATE, ASSERT] {
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) WithAssertion(f ASSERT) *TestCaseTB[SUT, STATE, ASSERT, T] {
 	ts.Assertion = f
 	return ts
 }
 
+// Skip this case with the given reason. Unlike commenting out the case, its StateBuilder is still inherited by all
+// further tests.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) Skip(reason string) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	if reason == "" {
+		reason = "skipped"
+	}
+
+	ts.SkipReason = reason
+
+	return ts
+}
+
+// Only runs this case and the other cases marked Only, all other cases are skipped. The StateBuilder's of skipped cases
+// are still inherited.
+func (ts *TestCaseTB[SUT, STATE, ASSERT, T]) Only() *TestCaseTB[SUT, STATE, ASSERT, T] {
+	ts.Focused = true
+	return ts
+}
+
 // Register the test to the TestsBuilder
-func (ts *TestsBuilder[SUT, STATE, ASSERT]) Register(name string) *TestCase[SUT, STATE, ASSERT] {
-	testcase := &TestCase[SUT, STATE, ASSERT]{
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Register(name string) *TestCaseTB[SUT, STATE, ASSERT, T] {
+	testcase := &TestCaseTB[SUT, STATE, ASSERT, T]{
 		TestName: name,
 	}
 	ts.TestCases = append(ts.TestCases, testcase)
@@ -90,43 +191,408 @@ func (ts *TestsBuilder[SUT, STATE, ASSERT]) Register(name string) *TestCase[SUT,
 // - Third TestCase: TestCase[2].SpecificBuilder(TestCase[0..2].StateBuilder(SUT, STATE))
 // - ...
 // - Nth TestCase: TestCase[n].SpecificBuilder(TestCase[0..n].StateBuilder(SUT, STATE))
-func (ts *TestsBuilder[SUT, STATE, ASSERT]) Tests() iter.Seq2[string, func(t *testing.T) TestData[SUT, STATE, ASSERT]] {
-	return func(yield func(string, func(t *testing.T) TestData[SUT, STATE, ASSERT]) bool) {
+//
+// The clean SUT and STATE are their zero values, unless TestsBuilder.WithInitial is used to construct them. The
+// TestCase.Teardown of every applied StateBuilder is registered with t.Cleanup and therefore runs in reverse order.
+//
+// When a TestCase inherits From another TestCase, only the StateBuilder's of its ancestors are applied. E.g. when
+// TestCase[3] inherits From TestCase[1]:
+// - Fourth TestCase: TestCase[3].SpecificBuilder(TestCase[0,1,3].StateBuilder(SUT, STATE))
+//
+// The test fails when a parent is unknown or when the inheritance is cyclic. Skipped cases (see TestCase.Skip,
+// TestCase.Only and Filter) are yielded as well and call t.Skip when built. A TestCase with Variants (see
+// TestCase.Matrix) is yielded once per variant, named "<TestName>/<variant Name>".
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Tests() iter.Seq2[string, func(t T) TestData[SUT, STATE, ASSERT]] {
+	return func(yield func(string, func(t T) TestData[SUT, STATE, ASSERT]) bool) {
+		ts := ts.scoped()
+
 		for i, curcase := range ts.TestCases {
-			build := func(t *testing.T) TestData[SUT, STATE, ASSERT] {
-				t.Helper()
+			if len(curcase.Variants) == 0 {
+				if !yield(curcase.TestName, ts.buildFunc(i, noVariant)) {
+					return
+				}
 
-				var (
-					sut   SUT
-					state STATE
-				)
+				continue
+			}
 
-				for j, testcase := range ts.TestCases {
-					if builder := testcase.StateBuilder; builder != nil {
-						builder(t, &sut, &state)
-					}
+			for v, variant := range curcase.Variants {
+				if !yield(curcase.TestName+"/"+variant.Name, ts.buildFunc(i, v)) {
+					return
+				}
+			}
+		}
+	}
+}
 
-					if j < i {
-						continue
-					}
+// buildFunc returns the function yielded by TestsBuilder.Tests for the TestCase at index and its variant
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) buildFunc(index int, variant int) func(t T) TestData[SUT, STATE, ASSERT] {
+	return func(t T) TestData[SUT, STATE, ASSERT] {
+		t.Helper()
 
-					if testcase.SpecificBuilder != nil {
-						testcase.SpecificBuilder(t, &sut, &state)
-					}
+		data, err := ts.buildVariant(t, index, variant)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-					break
-				}
+		return data
+	}
+}
 
-				return TestData[SUT, STATE, ASSERT]{
-					SUT:    sut,
-					State:  state,
-					Assert: curcase.Assertion,
-				}
+// Build the TestData of the TestCase at index by applying the StateBuilder's of its ancestors and itself, followed by
+// its own SpecificBuilder. See TestsBuilder.Tests.
+//
+// When the TestCase is skipped (see TestCase.Skip, TestCase.Only and Filter), t.Skip is called and Build does not
+// return.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Build(t T, index int) (TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	return ts.buildVariant(t, index, noVariant)
+}
+
+// buildVariant is TestsBuilder.BuildVariant which accepts noVariant, a failure after the build (e.g. reported by a mock
+// that stored t) belongs to the assert phase
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) buildVariant(t T, index int, variant int) (TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	o := newOptions(ts.opts)
+
+	t, data, err := ts.prepare(t, index, variant, &o)
+	if err == nil {
+		o.enterUntilCleanup(t, testPhase("assert", ts.TestCases[index].TestName))
+	}
+
+	return data, err
+}
+
+// prepare tracks the phases of the test in o, skips the TestCase at index when it is skipped and builds it with its
+// variant. It returns the T that prefixes failures with the phase, see trackPhases.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) prepare(
+	t T,
+	index int,
+	variant int,
+	o *options,
+) (T, TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	t = trackPhases(t, o)
+
+	ts.skip(t, index, *o)
+
+	data, err := ts.build(t, index, variant, *o)
+
+	return t, data, err
+}
+
+// skip calls t.Skip when the TestCase at index is skipped
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) skip(t T, index int, o options) {
+	t.Helper()
+
+	if reason, skipped := ts.skipped(index, o); skipped {
+		t.Skip(reason)
+	}
+}
+
+// skipped reports whether the TestCase at index is skipped and why
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) skipped(index int, o options) (string, bool) {
+	if index < 0 || index >= len(ts.TestCases) {
+		return "", false
+	}
+
+	testcase := ts.TestCases[index]
+	if testcase.SkipReason != "" {
+		return testcase.SkipReason, true
+	}
+
+	if !testcase.Focused && slices.ContainsFunc(ts.TestCases, func(tc *TestCaseTB[SUT, STATE, ASSERT, T]) bool {
+		return tc.Focused
+	}) {
+		return "another case is marked Only", true
+	}
+
+	for _, expr := range o.filters {
+		if !matchTags(expr, testcase.Tags) {
+			return fmt.Sprintf("excluded by tag filter %q", expr), true
+		}
+	}
+
+	return "", false
+}
+
+// noVariant builds a TestCase without any of its Variants
+const noVariant = -1
+
+// build is TestsBuilder.BuildVariant with the options resolved by the caller, use noVariant to build without a variant
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) build(
+	t T,
+	index int,
+	variant int,
+	o options,
+) (TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	if o.validate {
+		if err := ts.Validate(); err != nil {
+			return TestData[SUT, STATE, ASSERT]{}, err
+		}
+	}
+
+	chain, err := ts.chain(index)
+	if err != nil {
+		return TestData[SUT, STATE, ASSERT]{}, err
+	}
+
+	var (
+		sut   SUT
+		state STATE
+	)
+
+	if o.diffs {
+		o.snapshots = &snapshots{}
+		o.snapshots.take("zero values", sut, state)
+
+		t.Cleanup(func() {
+			o.reportDiffs(t)
+		})
+	}
+
+	if err := ts.applyChain(t, chain, o, &sut, &state); err != nil {
+		return TestData[SUT, STATE, ASSERT]{}, err
+	}
+
+	if err := ts.apply(t, o, KindSpecific, index, index, &sut, &state); err != nil {
+		return TestData[SUT, STATE, ASSERT]{}, err
+	}
+
+	if variant != noVariant {
+		step, err := ts.variant(index, variant)
+		if err != nil {
+			return TestData[SUT, STATE, ASSERT]{}, err
+		}
+
+		if err := ts.applyStep(t, o, KindSpecific, index, index, step, &sut, &state); err != nil {
+			return TestData[SUT, STATE, ASSERT]{}, err
+		}
+	}
+
+	return TestData[SUT, STATE, ASSERT]{
+		SUT:    sut,
+		State:  state,
+		Assert: ts.TestCases[index].Assertion,
+	}, nil
+}
+
+// applyChain initializes the SUT and STATE and applies the StateBuilder's of the chain. With checkpoints enabled, it
+// continues from the deepest checkpoint in the chain and stores a checkpoint after every StateBuilder it applies.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) applyChain(t T, chain []int, o options, sut *SUT, state *STATE) error {
+	t.Helper()
+
+	clone, err := resolveClone[SUT, STATE](o)
+	if err != nil {
+		return err
+	}
+
+	var (
+		start int
+		cache *checkpoints[SUT, STATE, ASSERT, T]
+	)
+
+	if clone != nil {
+		if err := ts.checkTeardowns(chain); err != nil {
+			return err
+		}
+
+		cache = ts.checkpoints
+		if cache == nil {
+			cache = &checkpoints[SUT, STATE, ASSERT, T]{}
+		}
+
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		start = cache.restore(ts.TestCases, chain, clone, sut, state)
+
+		if start > 0 {
+			owner := chain[start-1]
+			label := fmt.Sprintf("checkpoint[%d] %s", owner, ts.TestCases[owner].TestName)
+
+			if o.tracing {
+				t.Logf("%s", label)
+			}
+
+			o.snapshots.take(label, *sut, *state)
+		}
+	}
+
+	if start == 0 && o.initial != nil {
+		initial, ok := o.initial.(func(t T) (SUT, STATE))
+		if !ok {
+			return fmt.Errorf("%w: Initial expects %T, got %T", ErrInvalidOption, initial, o.initial)
+		}
+
+		began := time.Now()
+
+		func() {
+			defer o.enter(t, "arrange: initial")()
+
+			*sut, *state = initial(t)
+		}()
+
+		if o.tracing {
+			o.trace(t, "initial", began)
+		}
+
+		o.snapshots.take("initial", *sut, *state)
+	}
+
+	for k, j := range chain {
+		testcase := ts.TestCases[j]
+
+		if k >= start {
+			if err := ts.apply(t, o, KindState, j, chain[len(chain)-1], sut, state); err != nil {
+				return err
 			}
 
-			if !yield(curcase.TestName, build) {
-				return
+			if cache != nil {
+				cache.store(testcase, clone, *sut, *state)
 			}
 		}
+
+		if teardown := testcase.Teardown; teardown != nil {
+			t.Cleanup(func() {
+				defer o.enter(t, fmt.Sprintf("teardown: '%s'", testcase.TestName))()
+
+				teardown(t, sut, state)
+			})
+		}
 	}
+
+	return nil
+}
+
+// apply the builders of the given kind of the TestCase at owner while building the TestCase at index
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) apply(
+	t T,
+	o options,
+	kind BuilderKind,
+	owner int,
+	index int,
+	sut *SUT,
+	state *STATE,
+) error {
+	t.Helper()
+
+	for position, step := range ts.TestCases[owner].steps(kind) {
+		if kind == KindState && o.omit != nil && *o.omit == (auditStep{owner: owner, position: position}) {
+			continue
+		}
+
+		if err := ts.applyStep(t, o, kind, owner, index, step, sut, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyStep runs a single builder of the TestCase at owner while building the TestCase at index. A returned error or a
+// recovered panic is wrapped in a BuildError.
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) applyStep(
+	t T,
+	o options,
+	kind BuilderKind,
+	owner int,
+	index int,
+	step StepTB[SUT, STATE, T],
+	sut *SUT,
+	state *STATE,
+) (err error) {
+	t.Helper()
+
+	testcase := ts.TestCases[owner]
+
+	wrap := func(err error) error {
+		return &BuildError{
+			Kind:          kind,
+			Step:          step.Name,
+			Case:          testcase.TestName,
+			Index:         owner,
+			Building:      ts.TestCases[index].TestName,
+			BuildingIndex: index,
+			Err:           err,
+		}
+	}
+
+	label := stepLabel(kind, owner, testcase.TestName, step.Name)
+
+	if o.tracing {
+		defer o.trace(t, label, time.Now())
+	}
+
+	defer o.enter(t, arrangePhase(kind, step.Name, testcase.TestName))()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = wrap(&PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+
+	if err := step.Func(t, sut, state); err != nil {
+		return wrap(err)
+	}
+
+	o.snapshots.take(label, *sut, *state)
+
+	return nil
+}
+
+// chain resolves the indices of the TestCase's whose StateBuilder is applied for the TestCase at index, ordered from
+// the root to the TestCase itself
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) chain(index int) ([]int, error) {
+	if index < 0 || index >= len(ts.TestCases) {
+		return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	var chain []int
+
+	for cur := index; cur >= 0; {
+		if slices.Contains(chain, cur) {
+			names := make([]string, 0, len(chain)+1)
+			for _, j := range chain {
+				names = append(names, fmt.Sprintf("%q", ts.TestCases[j].TestName))
+			}
+
+			names = append(names, fmt.Sprintf("%q", ts.TestCases[cur].TestName))
+
+			return nil, fmt.Errorf("%w: %s", ErrCyclicInheritance, strings.Join(names, " -> "))
+		}
+
+		chain = append(chain, cur)
+
+		parent, err := ts.parent(cur)
+		if err != nil {
+			return nil, err
+		}
+
+		cur = parent
+	}
+
+	slices.Reverse(chain)
+
+	return chain, nil
+}
+
+// parent resolves the index of the TestCase that the TestCase at index inherits from, or -1 when it is the root
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) parent(index int) (int, error) {
+	testcase := ts.TestCases[index]
+	if testcase.Parent == "" {
+		return index - 1, nil
+	}
+
+	for j, candidate := range ts.TestCases {
+		if candidate.TestName == testcase.Parent {
+			return j, nil
+		}
+	}
+
+	return -1, fmt.Errorf("%w: case %q (#%d) inherits from %q", ErrUnknownParent, testcase.TestName, index, testcase.Parent)
 }
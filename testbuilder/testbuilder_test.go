@@ -23,14 +23,15 @@ func TestTestCase_WithStateBuilder(t *testing.T) {
 		})
 
 	// Assert
-	assert.Equal(t, testcase, res) // pointer equal
-	require.NotNil(t, testcase.StateBuilder)
+	assert.Equal(t, testcase, res)       // pointer equal
+	assert.Nil(t, testcase.StateBuilder) // only set by a struct literal
+	require.Len(t, testcase.StateSteps, 1)
 
 	var sut string
 
 	var state string
 
-	testcase.StateBuilder(t, &sut, &state)
+	require.NoError(t, testcase.StateSteps[0].Func(t, &sut, &state))
 	assert.Equal(t, "sut", sut)
 	assert.Equal(t, "state", state)
 }
@@ -50,15 +51,16 @@ func TestTestCase_WithSpecificBuilder(t *testing.T) {
 		})
 
 	// Assert
-	assert.Equal(t, testcase, res) // pointer equal
-	require.NotNil(t, testcase.SpecificBuilder)
+	assert.Equal(t, testcase, res)          // pointer equal
+	assert.Nil(t, testcase.SpecificBuilder) // only set by a struct literal
+	require.Len(t, testcase.SpecificSteps, 1)
 
 	var (
 		sut   string
 		state string
 	)
 
-	testcase.SpecificBuilder(t, &sut, &state)
+	require.NoError(t, testcase.SpecificSteps[0].Func(t, &sut, &state))
 
 	assert.Equal(t, "sut", sut)
 	assert.Equal(t, "state", state)
@@ -275,3 +277,363 @@ func TestTestsBuilder_MultipleTests_PreviousSpecificTestsAreIgnored(t *testing.T
 		})
 	}
 }
+
+func TestTestsBuilder_MultipleTests_FromInheritsAncestorsOnly(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, int, func(t *testing.T)]{}
+	builder.Register("root").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut = "a"
+		})
+	builder.Register("regular").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "b"
+		})
+	builder.Register("regular next").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "c"
+		})
+	builder.Register("admin").
+		From("root").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "x"
+		})
+	builder.Register("admin next").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "y"
+		})
+
+	results := map[string]string{
+		"root":         "a",
+		"regular":      "ab",
+		"regular next": "abc",
+		"admin":        "ax",
+		"admin next":   "axy",
+	}
+
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			testData := testBuilder(t)
+
+			// Assert
+			assert.Equal(t, results[testName], testData.SUT)
+		})
+	}
+}
+
+func TestTestsBuilder_Build_InheritanceErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown parent", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		builder := TestsBuilder[string, string, func()]{}
+		builder.Register("a")
+		builder.Register("b").From("does not exist")
+
+		// Act
+		_, err := builder.Build(t, 1)
+
+		// Assert
+		require.ErrorIs(t, err, ErrUnknownParent)
+		assert.EqualError(t, err, `unknown parent: case "b" (#1) inherits from "does not exist"`)
+	})
+
+	t.Run("cyclic inheritance", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		builder := TestsBuilder[string, string, func()]{}
+		builder.Register("a").From("c")
+		builder.Register("b")
+		builder.Register("c")
+
+		// Act
+		_, err := builder.Build(t, 2)
+
+		// Assert
+		require.ErrorIs(t, err, ErrCyclicInheritance)
+		assert.EqualError(t, err, `cyclic inheritance: "c" -> "b" -> "a" -> "c"`)
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		builder := TestsBuilder[string, string, func()]{}
+		builder.Register("a")
+
+		// Act
+		_, err := builder.Build(t, 1)
+
+		// Assert
+		require.ErrorIs(t, err, ErrIndexOutOfRange)
+	})
+}
+
+func TestTestCase_WithTeardown(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	testcase := &TestCase[string, string, func()]{}
+
+	// Act
+	res := testcase.
+		WithTeardown(func(t *testing.T, sut *string, state *string) {
+			t.Helper()
+
+			*sut = "teardown"
+		})
+
+	// Assert
+	assert.Equal(t, testcase, res) // pointer equal
+	require.NotNil(t, testcase.Teardown)
+}
+
+func TestTestsBuilder_MultipleTests_TeardownInReverseChainOrder(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, int, func()]{}
+	teardowns := make(map[string][]string)
+
+	for _, name := range []string{"1", "2", "3"} {
+		builder.Register(name).
+			WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+				t.Helper()
+
+				*sut += name
+			}).
+			WithTeardown(func(t *testing.T, sut *string, _ *int) {
+				t.Helper()
+
+				teardowns[t.Name()] = append(teardowns[t.Name()], name+":"+*sut)
+			})
+	}
+
+	// Act
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			testBuilder(t)
+		})
+	}
+
+	// Assert
+	assert.Equal(t, map[string][]string{
+		t.Name() + "/1": {"1:1"},
+		t.Name() + "/2": {"2:12", "1:12"},
+		t.Name() + "/3": {"3:123", "2:123", "1:123"},
+	}, teardowns)
+}
+
+func TestTestCase_WithBuilderE(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	testcase := &TestCase[string, string, func()]{}
+
+	// Act
+	res := testcase.
+		WithStateBuilderE(func(t *testing.T, sut *string, state *string) error {
+			t.Helper()
+
+			return nil
+		}).
+		WithSpecificBuilderE(func(t *testing.T, sut *string, state *string) error {
+			t.Helper()
+
+			return assert.AnError
+		})
+
+	// Assert
+	assert.Equal(t, testcase, res) // pointer equal
+	require.Len(t, testcase.StateSteps, 1)
+	require.Len(t, testcase.SpecificSteps, 1)
+	require.ErrorIs(t, testcase.SpecificSteps[0].Func(t, nil, nil), assert.AnError)
+}
+
+func TestTestsBuilder_Build_BuilderErrorAttribution(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("invalid payload").
+		WithStateBuilderE(func(t *testing.T, sut *string, _ *string) error {
+			t.Helper()
+
+			*sut += "a"
+
+			return nil
+		})
+	builder.Register("send mail failure").
+		WithStateBuilderE(func(t *testing.T, _ *string, _ *string) error {
+			t.Helper()
+
+			return assert.AnError
+		})
+	builder.Register("success")
+	builder.Register("specific failure").
+		From("invalid payload").
+		WithSpecificBuilderE(func(t *testing.T, _ *string, _ *string) error {
+			t.Helper()
+
+			return assert.AnError
+		})
+
+	t.Run("inherited state builder", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		_, err := builder.Build(t, 2)
+
+		// Assert
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, KindState, buildErr.Kind)
+		assert.Equal(t, 1, buildErr.Index)
+		assert.Equal(t, 2, buildErr.BuildingIndex)
+		assert.EqualError(t, err, "state builder of case 'send mail failure' (#1) failed while building case "+
+			"'success' (#2): "+assert.AnError.Error())
+	})
+
+	t.Run("specific builder", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		_, err := builder.Build(t, 3)
+
+		// Assert
+		assert.EqualError(t, err, "specific builder of case 'specific failure' (#3) failed while building case "+
+			"'specific failure' (#3): "+assert.AnError.Error())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		data, err := builder.Build(t, 0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "a", data.SUT)
+	})
+}
+
+func TestTestsBuilder_Build_PanicInBuilderIsRecovered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("ok")
+	builder.Register("panics").
+		WithSpecificBuilder(func(t *testing.T, _ *string, _ *string) {
+			t.Helper()
+
+			panic(assert.AnError)
+		})
+
+	// Act
+	_, err := builder.Build(t, 1)
+
+	// Assert
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, assert.AnError, panicErr.Value)
+	assert.NotEmpty(t, panicErr.Stack)
+	assert.EqualError(t, err, "specific builder of case 'panics' (#1) panicked while building case 'panics' (#1): "+
+		assert.AnError.Error())
+}
+
+func TestTestCase_SkipAndOnly(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	testcase := &TestCase[string, string, func()]{}
+
+	// Act
+	res := testcase.Skip("flaky").Only()
+
+	// Assert
+	assert.Equal(t, testcase, res) // pointer equal
+	assert.Equal(t, "flaky", testcase.SkipReason)
+	assert.True(t, testcase.Focused)
+	assert.Equal(t, "skipped", (&TestCase[string, string, func()]{}).Skip("").SkipReason)
+}
+
+func TestTestsBuilder_Tests_SkippedCaseKeepsChain(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, int, func()]{}
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut = "a"
+		})
+	builder.Register("2").
+		Skip("work in progress").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "b"
+		})
+	builder.Register("3").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "c"
+		})
+
+	results := make(map[string]string)
+	skipped := make(map[string]bool)
+
+	// Act
+	for testName, testBuilder := range builder.Tests() {
+		t.Run(testName, func(t *testing.T) {
+			t.Cleanup(func() {
+				skipped[testName] = t.Skipped()
+			})
+
+			results[testName] = testBuilder(t).SUT
+		})
+	}
+
+	// Assert
+	assert.Equal(t, map[string]string{"1": "a", "3": "abc"}, results)
+	assert.Equal(t, map[string]bool{"1": false, "2": true, "3": false}, skipped)
+}
+
+func TestTestsBuilder_Run_OnlySkipsOtherCases(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, int, func()]{}
+	builder.Register("1").
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut = "a"
+		})
+	builder.Register("2").
+		Only().
+		WithStateBuilder(func(t *testing.T, sut *string, _ *int) {
+			t.Helper()
+
+			*sut += "b"
+		})
+	builder.Register("3")
+
+	results := make(map[string]string)
+
+	// Act
+	t.Run("run", func(t *testing.T) {
+		builder.Run(t, func(t *testing.T, data TestData[string, int, func()]) {
+			results[t.Name()] = data.SUT
+		})
+	})
+
+	// Assert
+	assert.Equal(t, map[string]string{"TestTestsBuilder_Run_OnlySkipsOtherCases/run/2": "ab"}, results)
+}
@@ -0,0 +1,42 @@
+package testbuilder
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TraceEnv is the environment variable that enables Trace for every TestsBuilder, e.g. TESTBUILDER_TRACE=1
+const TraceEnv = "TESTBUILDER_TRACE"
+
+// Trace logs every builder that is applied while building a TestCase with its duration, e.g.
+//
+//	initial (1.2µs)
+//	state[0] invalid payload (310ns)
+//	state[1] get user failure (25.1µs)
+//	specific[2] send mail failure (4.3µs)
+//
+// The number is the index of the TestCase that owns the builder. With Checkpoints, a restored checkpoint is logged as
+// checkpoint[n] instead of the StateBuilder's it replaces.
+func Trace() Option {
+	return func(o *options) {
+		o.tracing = true
+	}
+}
+
+// trace logs a build step with the time elapsed since began
+func (o options) trace(t testing.TB, step string, began time.Time) {
+	t.Helper()
+
+	t.Logf("%s (%s)", step, time.Since(began))
+}
+
+// stepLabel is the label of a builder in the Trace output, e.g. "state[1] get user failure: mock get user"
+func stepLabel(kind BuilderKind, owner int, testName string, stepName string) string {
+	label := fmt.Sprintf("%s[%d] %s", kind.short(), owner, testName)
+	if stepName != "" {
+		label += ": " + stepName
+	}
+
+	return label
+}
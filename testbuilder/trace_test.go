@@ -0,0 +1,116 @@
+package testbuilder
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// traced returns the trace output logged to the recorder without durations
+func traced(recorder *recordingTB) []string {
+	duration := regexp.MustCompile(` \(.*\)$`)
+
+	lines := make([]string, 0, len(recorder.logs))
+	for _, line := range recorder.logs {
+		lines = append(lines, duration.ReplaceAllString(line, ""))
+	}
+
+	return lines
+}
+
+func newTraceBuilder() *TestsBuilderTB[string, string, func(), *recordingTB] {
+	builder := &TestsBuilderTB[string, string, func(), *recordingTB]{}
+	builder.WithInitial(func(*recordingTB) (string, string) {
+		return "", ""
+	})
+	builder.Register("invalid payload").
+		WithSpecificBuilder(func(*recordingTB, *string, *string) {})
+	builder.Register("get user failure").
+		WithStateBuilder(func(*recordingTB, *string, *string) {})
+	builder.Register("send mail failure").
+		WithStateBuilderE(func(*recordingTB, *string, *string) error {
+			return nil
+		}).
+		WithSpecificBuilder(func(*recordingTB, *string, *string) {})
+
+	return builder
+}
+
+func TestTrace(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := newTraceBuilder().With(Trace())
+
+	// Act
+	_, err := builder.Build(recorder, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"initial",
+		"state[1] get user failure",
+		"state[2] send mail failure",
+		"specific[2] send mail failure",
+	}, traced(recorder))
+}
+
+func TestTrace_Checkpoints(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := &recordingTB{}
+
+	builder := newTraceBuilder().With(Trace())
+	builder.WithClone(func(sut string, state string) (string, string) {
+		return sut, state
+	})
+
+	_, err := builder.Build(&recordingTB{}, 1)
+	require.NoError(t, err)
+
+	// Act
+	_, err = builder.Build(recorder, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"checkpoint[1] get user failure",
+		"state[2] send mail failure",
+		"specific[2] send mail failure",
+	}, traced(recorder))
+}
+
+func TestTrace_Env(t *testing.T) {
+	// Arrange
+	recorder := &recordingTB{}
+
+	t.Setenv(TraceEnv, "1")
+
+	builder := newTraceBuilder()
+
+	// Act
+	_, err := builder.Build(recorder, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"initial", "specific[0] invalid payload"}, traced(recorder))
+}
+
+func TestTrace_Disabled(t *testing.T) {
+	// Arrange
+	recorder := &recordingTB{}
+
+	t.Setenv(TraceEnv, "0")
+
+	builder := newTraceBuilder()
+
+	// Act
+	_, err := builder.Build(recorder, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, recorder.logs)
+}
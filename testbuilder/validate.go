@@ -0,0 +1,117 @@
+package testbuilder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// ValidationError attributes a structural mistake found by TestsBuilder.Validate to a TestCase
+type ValidationError struct {
+	// Case is the TestName of the TestCase
+	Case string
+	// Index of the TestCase
+	Index int
+	// Err is one of the sentinel errors, e.g. ErrDuplicateName
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("case '%s' (#%d): %v", e.Case, e.Index, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// AutoValidate calls TestsBuilder.Validate before building a TestCase, which fails the test on any structural mistake
+func AutoValidate() Option {
+	return func(o *options) {
+		o.validate = true
+	}
+}
+
+// Validate the structure of the registered TestCase's. It returns an errors.Join of a ValidationError per problem:
+// - ErrEmptyName or ErrDuplicateName: breaks t.Run naming
+// - ErrNilAssertion: the TestCase has nothing to assert
+// - ErrNoBuilders: the TestCase has neither a StateBuilder nor a SpecificBuilder
+// - ErrUnusedStateBuilder: the TestCase has a StateBuilder while no TestCase inherits it, use a SpecificBuilder instead
+// - ErrUnknownParent or ErrCyclicInheritance: see TestCase.From
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) Validate() error {
+	errs := make([]error, 0)
+	invalid := func(index int, err error) {
+		errs = append(errs, &ValidationError{Case: ts.TestCases[index].TestName, Index: index, Err: err})
+	}
+
+	names := make(map[string]int, len(ts.TestCases))
+	inherited := make(map[int]bool, len(ts.TestCases))
+
+	for i, testcase := range ts.TestCases {
+		switch first, ok := names[testcase.TestName]; {
+		case testcase.TestName == "":
+			invalid(i, ErrEmptyName)
+		case ok:
+			invalid(i, fmt.Errorf("%w: also used by case #%d", ErrDuplicateName, first))
+		default:
+			names[testcase.TestName] = i
+		}
+
+		if isZero(testcase.Assertion) {
+			invalid(i, ErrNilAssertion)
+		}
+
+		if !testcase.hasBuilders(KindState) && !testcase.hasBuilders(KindSpecific) && len(testcase.Variants) == 0 {
+			invalid(i, ErrNoBuilders)
+		}
+
+		parent, err := ts.parent(i)
+		if err != nil {
+			invalid(i, err)
+			continue
+		}
+
+		inherited[parent] = true
+
+		// the cycle is reported once, for the first registered TestCase on it
+		if _, err := ts.chain(i); errors.Is(err, ErrCyclicInheritance) && slices.Min(ts.cycle(i)) == i {
+			invalid(i, err)
+		}
+	}
+
+	for i, testcase := range ts.TestCases {
+		if !inherited[i] && testcase.hasBuilders(KindState) {
+			invalid(i, ErrUnusedStateBuilder)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// cycle returns the indices of the TestCase's on the cycle that is reached from the TestCase at index, or nil when its
+// chain has no cycle
+func (ts *TestsBuilderTB[SUT, STATE, ASSERT, T]) cycle(index int) []int {
+	var visited []int
+
+	for cur := index; cur >= 0; {
+		if at := slices.Index(visited, cur); at >= 0 {
+			return visited[at:]
+		}
+
+		visited = append(visited, cur)
+
+		parent, err := ts.parent(cur)
+		if err != nil {
+			return nil
+		}
+
+		cur = parent
+	}
+
+	return nil
+}
+
+// isZero reports whether v is the zero value of its type, e.g. a nil func or an empty struct
+func isZero[V any](v V) bool {
+	return reflect.ValueOf(&v).Elem().IsZero()
+}
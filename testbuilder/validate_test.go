@@ -0,0 +1,153 @@
+package testbuilder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noopBuilder(t *testing.T, _ *string, _ *string) {
+	t.Helper()
+}
+
+func TestTestsBuilder_Validate_Valid(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("1").WithStateBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("2").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+
+	// Act
+	err := builder.Validate()
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestTestsBuilder_Validate_Problems(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("").WithStateBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("dup").WithStateBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("dup").WithStateBuilder(noopBuilder)
+	builder.Register("nothing").WithAssertion(func() {})
+	builder.Register("orphan").From("missing").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("cycle a").From("cycle b").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("cycle b").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("last").WithStateBuilder(noopBuilder).WithAssertion(func() {})
+
+	// Act
+	err := builder.Validate()
+
+	// Assert
+	require.Error(t, err)
+
+	var problems []string
+
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() { //nolint:errorlint,forcetypeassert // errors.Join
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+
+		problems = append(problems, err.Error())
+	}
+
+	assert.Equal(t, []string{
+		"case '' (#0): empty name",
+		"case 'dup' (#2): duplicate name: also used by case #1",
+		"case 'dup' (#2): nil assertion",
+		"case 'nothing' (#3): no builders",
+		`case 'orphan' (#4): unknown parent: case "orphan" (#4) inherits from "missing"`,
+		`case 'cycle a' (#5): cyclic inheritance: "cycle a" -> "cycle b" -> "cycle a"`,
+		"case 'last' (#7): state builder is not inherited by any case",
+	}, problems)
+
+	require.ErrorIs(t, err, ErrDuplicateName)
+	require.ErrorIs(t, err, ErrUnknownParent)
+	require.ErrorIs(t, err, ErrCyclicInheritance)
+}
+
+func TestAutoValidate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	builder := TestsBuilder[string, string, func()]{}
+	builder.Register("1").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+	builder.Register("1").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+
+	_, err := builder.Build(t, 0)
+	require.NoError(t, err)
+
+	// Act
+	builder.With(AutoValidate())
+	_, err = builder.Build(t, 0)
+
+	// Assert
+	require.ErrorIs(t, err, ErrDuplicateName)
+	assert.False(t, errors.Is(err, ErrNilAssertion))
+}
+
+type validateAssert struct {
+	Name string
+}
+
+func TestTestsBuilder_Validate_Assertion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validate func() error
+		err      error
+	}{
+		"nil func": {
+			validate: func() error {
+				builder := TestsBuilder[string, string, func()]{}
+				builder.Register("1").WithSpecificBuilder(noopBuilder)
+
+				return builder.Validate()
+			},
+			err: ErrNilAssertion,
+		},
+		"func": {
+			validate: func() error {
+				builder := TestsBuilder[string, string, func()]{}
+				builder.Register("1").WithSpecificBuilder(noopBuilder).WithAssertion(func() {})
+
+				return builder.Validate()
+			},
+		},
+		"zero struct": {
+			validate: func() error {
+				builder := TestsBuilder[string, string, validateAssert]{}
+				builder.Register("1").WithSpecificBuilder(noopBuilder)
+
+				return builder.Validate()
+			},
+			err: ErrNilAssertion,
+		},
+		"struct": {
+			validate: func() error {
+				builder := TestsBuilder[string, string, validateAssert]{}
+				builder.Register("1").WithSpecificBuilder(noopBuilder).WithAssertion(validateAssert{Name: "1"})
+
+				return builder.Validate()
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			err := tc.validate()
+
+			// Assert
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,57 +2,253 @@ package testslicebuilder
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Emptyless/go-testbuilder/testbuilder"
 )
 
-type TableTestItem[SUT any, STATE any, ASSERT any] struct {
-	Name            string
-	StateBuilder    func(t *testing.T, sut *SUT, state *STATE)
-	SpecificBuilder func(t *testing.T, sut *SUT, state *STATE)
-	Assertion       ASSERT
+// TableTestItem is a test case of a table test, see testbuilder.TestCase for the meaning of the fields
+type TableTestItem[SUT any, STATE any, ASSERT any] = TableTestItemTB[SUT, STATE, ASSERT, *testing.T]
+
+// TableTestItemTB is TableTestItem for any testing.TB, see testbuilder.TestsBuilderTB
+type TableTestItemTB[SUT any, STATE any, ASSERT any, T testing.TB] struct {
+	Name string
+	// Description of the scenario for readers of the Spec
+	Description string
+	// Parent is the Name of the item to inherit the StateBuilder chain from. When empty, the item inherits from the
+	// item before it. See testbuilder.TestCase.From.
+	Parent          string
+	StateBuilder    func(t T, sut *SUT, state *STATE)
+	SpecificBuilder func(t T, sut *SUT, state *STATE)
+	// StateSteps are builders that run after StateBuilder, e.g. named or error-returning ones, see
+	// testbuilder.TestCase.StateSteps
+	StateSteps []testbuilder.StepTB[SUT, STATE, T]
+	// SpecificSteps are builders that run after SpecificBuilder
+	SpecificSteps []testbuilder.StepTB[SUT, STATE, T]
+	// Variants expand the item into a subtest per variant, see testbuilder.TestCase.Matrix and testbuilder.Vary
+	Variants []testbuilder.StepTB[SUT, STATE, T]
+	// Teardown releases what the StateBuilder set up, see testbuilder.TestCase.Teardown
+	Teardown  func(t T, sut *SUT, state *STATE)
+	Assertion ASSERT
+	// Skip the item with this reason when not empty, its StateBuilder is still inherited by further items
+	Skip string
+	// Only runs the items marked Only, all other items are skipped
+	Only bool
+	// Tags select the item with testbuilder.Filter or TESTBUILDER_TAGS
+	Tags []string
 }
 
 // Sentinel errors for clarity and better testability
 var (
-	ErrIndexOutOfRange = errors.New("index out of range")
-	ErrNoTestsDefined  = errors.New("no tests defined")
+	ErrIndexOutOfRange   = testbuilder.ErrIndexOutOfRange
+	ErrNoTestsDefined    = errors.New("no tests defined")
+	ErrTestNotFound      = testbuilder.ErrTestNotFound
+	ErrUnknownParent     = testbuilder.ErrUnknownParent
+	ErrCyclicInheritance = testbuilder.ErrCyclicInheritance
+
+	// Errors of ValidateSlice, see testbuilder.TestsBuilder.Validate
+	ErrEmptyName          = testbuilder.ErrEmptyName
+	ErrDuplicateName      = testbuilder.ErrDuplicateName
+	ErrNilAssertion       = testbuilder.ErrNilAssertion
+	ErrNoBuilders         = testbuilder.ErrNoBuilders
+	ErrUnusedStateBuilder = testbuilder.ErrUnusedStateBuilder
 )
 
-func TestDataFromSlice[SUT any, STATE any, ASSERT any](
-	t *testing.T,
+// TestDataFromSlice builds the TestData of the item at testIndex by applying the StateBuilder's of its ancestors and
+// itself, followed by its own SpecificBuilder. See testbuilder.TestsBuilder.Build.
+//
+// The Option's configure the build, e.g. testbuilder.Initial constructs the SUT and STATE before any builder runs.
+func TestDataFromSlice[SUT any, STATE any, ASSERT any, T testing.TB](
+	t T,
 	testIndex int,
-	tests []TableTestItem[SUT, STATE, ASSERT],
+	tests []TableTestItemTB[SUT, STATE, ASSERT, T],
+	opts ...testbuilder.Option,
 ) (testbuilder.TestData[SUT, STATE, ASSERT], error) {
-	var sut SUT
+	t.Helper()
+
+	if len(tests) == 0 {
+		return testbuilder.TestData[SUT, STATE, ASSERT]{}, ErrNoTestsDefined
+	}
 
-	var state STATE
+	return testbuilder.FromSlice(tests).With(opts...).Build(t, testIndex)
+}
+
+// TestDataFromSliceVariant builds the TestData of the item at testIndex like TestDataFromSlice, followed by the variant
+// at position variant of its Variants. See testbuilder.TestsBuilder.BuildVariant.
+func TestDataFromSliceVariant[SUT any, STATE any, ASSERT any, T testing.TB](
+	t T,
+	testIndex int,
+	variant int,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, T],
+	opts ...testbuilder.Option,
+) (testbuilder.TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
 
 	if len(tests) == 0 {
 		return testbuilder.TestData[SUT, STATE, ASSERT]{}, ErrNoTestsDefined
 	}
 
-	if testIndex < 0 || testIndex >= len(tests) {
-		return testbuilder.TestData[SUT, STATE, ASSERT]{}, ErrIndexOutOfRange
+	return testbuilder.FromSlice(tests).With(opts...).BuildVariant(t, testIndex, variant)
+}
+
+// TestDataByName builds the TestData of the item with the given name, see TestDataFromSlice. The name is either the
+// Name of the item or the name shown by go test -v, where spaces are replaced by underscores. It returns
+// ErrTestNotFound when no item has the name and ErrDuplicateName when multiple items have it.
+func TestDataByName[SUT any, STATE any, ASSERT any, T testing.TB](
+	t T,
+	name string,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, T],
+	opts ...testbuilder.Option,
+) (testbuilder.TestData[SUT, STATE, ASSERT], error) {
+	t.Helper()
+
+	if len(tests) == 0 {
+		return testbuilder.TestData[SUT, STATE, ASSERT]{}, ErrNoTestsDefined
 	}
 
-	// Build up to the index
-	for _, tc := range tests[:testIndex+1] {
-		if tc.StateBuilder != nil {
-			tc.StateBuilder(t, &sut, &state)
+	index := -1
+
+	for i, tc := range tests {
+		if tc.Name != name && strings.ReplaceAll(tc.Name, " ", "_") != name {
+			continue
 		}
+
+		if index >= 0 {
+			return testbuilder.TestData[SUT, STATE, ASSERT]{}, fmt.Errorf("%w: %q is used by items #%d and #%d",
+				ErrDuplicateName, name, index, i)
+		}
+
+		index = i
 	}
 
-	// Then run the specific builder at that index
-	target := tests[testIndex]
-	if target.SpecificBuilder != nil {
-		target.SpecificBuilder(t, &sut, &state)
+	if index < 0 {
+		return testbuilder.TestData[SUT, STATE, ASSERT]{}, fmt.Errorf("%w: %q", ErrTestNotFound, name)
+	}
+
+	return TestDataFromSlice(t, index, tests, opts...)
+}
+
+// ValidateSlice validates the structure of the items, e.g. duplicate names or a nil Assertion. It returns an
+// errors.Join of a testbuilder.ValidationError per problem, see testbuilder.TestsBuilder.Validate.
+func ValidateSlice[SUT any, STATE any, ASSERT any, T testing.TB](tests []TableTestItemTB[SUT, STATE, ASSERT, T]) error {
+	if len(tests) == 0 {
+		return ErrNoTestsDefined
+	}
+
+	return testbuilder.FromSlice(tests).Validate()
+}
+
+// Run creates a subtest for every TableTestItem, builds its TestData like TestDataFromSlice and passes it to act. The
+// act function exercises the SUT and hands its output to TestData.Assert. See testbuilder.TestsBuilder.Run.
+//
+// Note: JetBrains IDEs only detect the subtests when ranging over the slice directly, use TestDataFromSlice for that.
+func Run[SUT any, STATE any, ASSERT any, T testing.TB](
+	t *testing.T,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, T],
+	act func(t T, data testbuilder.TestData[SUT, STATE, ASSERT]),
+	opts ...testbuilder.Option,
+) {
+	t.Helper()
+
+	testbuilder.FromSlice(tests).Run(t, act, opts...)
+}
+
+// Spec exports the items with their descriptions and the builders that arrange them, see testbuilder.TestsBuilder.Spec
+func Spec[SUT any, STATE any, ASSERT any, T testing.TB](tests []TableTestItemTB[SUT, STATE, ASSERT, T]) testbuilder.Spec {
+	return testbuilder.FromSlice(tests).Spec()
+}
+
+// Audit finds the StateBuilder's that no passing TableTestItem needs, see testbuilder.Audit
+func Audit[SUT any, STATE any, ASSERT any](
+	t *testing.T,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, testing.TB],
+	act func(t testing.TB, data testbuilder.TestData[SUT, STATE, ASSERT]),
+	opts ...testbuilder.Option,
+) []testbuilder.AuditFinding {
+	t.Helper()
+
+	return testbuilder.Audit(t, testbuilder.FromSlice(tests), act, opts...)
+}
+
+// Benchmark creates a sub-benchmark for every TableTestItem that measures act, see testbuilder.Benchmark
+func Benchmark[SUT any, STATE any, ASSERT any](
+	b *testing.B,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, testing.TB],
+	act func(b testing.TB, data testbuilder.TestData[SUT, STATE, ASSERT]),
+	opts ...testbuilder.Option,
+) {
+	b.Helper()
+
+	testbuilder.Benchmark(b, testbuilder.FromSlice(tests), act, opts...)
+}
+
+// Fuzz the TableTestItem named name, see testbuilder.TestsBuilder.Fuzz
+func Fuzz[SUT any, STATE any, ASSERT any, T testing.TB](
+	f *testing.F,
+	name string,
+	tests []TableTestItemTB[SUT, STATE, ASSERT, T],
+	inject any,
+	run func(t T, data testbuilder.TestData[SUT, STATE, ASSERT]),
+	opts ...testbuilder.Option,
+) {
+	f.Helper()
+
+	testbuilder.FromSlice(tests).With(opts...).Fuzz(f, name, inject, run)
+}
+
+// ToTestCase converts the item into a testbuilder.TestCase with all metadata carried over, see testbuilder.FromSlice
+func (tc TableTestItemTB[SUT, STATE, ASSERT, T]) ToTestCase() *testbuilder.TestCaseTB[SUT, STATE, ASSERT, T] {
+	return &testbuilder.TestCaseTB[SUT, STATE, ASSERT, T]{
+		TestName:        tc.Name,
+		Description:     tc.Description,
+		Parent:          tc.Parent,
+		StateBuilder:    tc.StateBuilder,
+		SpecificBuilder: tc.SpecificBuilder,
+		StateSteps:      tc.StateSteps,
+		SpecificSteps:   tc.SpecificSteps,
+		Variants:        tc.Variants,
+		Teardown:        tc.Teardown,
+		Assertion:       tc.Assertion,
+		SkipReason:      tc.Skip,
+		Focused:         tc.Only,
+		Tags:            tc.Tags,
+	}
+}
+
+// FromTestCase converts a testbuilder.TestCase into a TableTestItem with all metadata carried over
+func FromTestCase[SUT any, STATE any, ASSERT any, T testing.TB](
+	testcase *testbuilder.TestCaseTB[SUT, STATE, ASSERT, T],
+) TableTestItemTB[SUT, STATE, ASSERT, T] {
+	return TableTestItemTB[SUT, STATE, ASSERT, T]{
+		Name:            testcase.TestName,
+		Description:     testcase.Description,
+		Parent:          testcase.Parent,
+		StateBuilder:    testcase.StateBuilder,
+		SpecificBuilder: testcase.SpecificBuilder,
+		StateSteps:      testcase.StateSteps,
+		SpecificSteps:   testcase.SpecificSteps,
+		Variants:        testcase.Variants,
+		Teardown:        testcase.Teardown,
+		Assertion:       testcase.Assertion,
+		Skip:            testcase.SkipReason,
+		Only:            testcase.Focused,
+		Tags:            testcase.Tags,
+	}
+}
+
+// FromBuilder converts the TestCase's of a testbuilder.TestsBuilder into a slice of TableTestItem's, with all per-case
+// metadata carried over. Options set with testbuilder.TestsBuilder.With are not part of the slice. See
+// testbuilder.FromSlice for the reverse.
+func FromBuilder[SUT any, STATE any, ASSERT any, T testing.TB](
+	builder *testbuilder.TestsBuilderTB[SUT, STATE, ASSERT, T],
+) []TableTestItemTB[SUT, STATE, ASSERT, T] {
+	tests := make([]TableTestItemTB[SUT, STATE, ASSERT, T], 0, len(builder.TestCases))
+
+	for _, testcase := range builder.TestCases {
+		tests = append(tests, FromTestCase(testcase))
 	}
 
-	return testbuilder.TestData[SUT, STATE, ASSERT]{
-		SUT:    sut,
-		State:  state,
-		Assert: target.Assertion,
-	}, nil
+	return tests
 }
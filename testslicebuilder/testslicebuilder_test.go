@@ -1,9 +1,11 @@
 package testslicebuilder
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/Emptyless/go-testbuilder/testbuilder"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -399,19 +401,21 @@ func Test_TestDataFromSlice_PanicInBuilder_DoesNotStopOthers(t *testing.T) {
 		t.Run(tt.Name, func(t *testing.T) {
 			actualNames = append(actualNames, tests[i].Name)
 
-			defer func() {
-				if r := recover(); r != nil {
-					t.Logf("Recovered panic in subtest %q: %v", tt.Name, r)
+			data, err := TestDataFromSlice(t, i, tests)
 
-					actualPanic, ok := r.(string)
+			var panicErr *testbuilder.PanicError
+			if errors.As(err, &panicErr) {
+				t.Logf("Recovered panic in subtest %q: %v", tt.Name, err)
 
-					assert.True(t, ok)
+				actualPanic, ok := panicErr.Value.(string)
 
-					actualPanics = append(actualPanics, actualPanic)
-				}
-			}()
+				assert.True(t, ok)
+
+				actualPanics = append(actualPanics, actualPanic)
+
+				return
+			}
 
-			data, err := TestDataFromSlice(t, i, tests)
 			require.NoError(t, err)
 
 			sutAllActualCalled = append(sutAllActualCalled, data.SUT.actualCalled)
@@ -429,3 +433,708 @@ func Test_TestDataFromSlice_PanicInBuilder_DoesNotStopOthers(t *testing.T) {
 		assert.Equal(t, expectedPanics, actualPanics)
 	})
 }
+
+func Test_Run(t *testing.T) {
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+				appendState(state, "stateA")
+			},
+			SpecificBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "specA")
+				appendState(state, "specA")
+			},
+			Assertion: DummyAssert{"assertA"},
+		},
+		{
+			Name: "B",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateB")
+				appendState(state, "stateB")
+			},
+			Assertion: DummyAssert{"assertB"},
+		},
+	}
+
+	actualNames := make([]string, 0)
+	actualAsserts := make([]string, 0)
+	sutAllActualCalled := make([][]string, 0)
+
+	Run(t, tests, func(t *testing.T, data testbuilder.TestData[DummySUT, DummyState, DummyAssert]) {
+		actualNames = append(actualNames, t.Name())
+		actualAsserts = append(actualAsserts, data.Assert.Name)
+		sutAllActualCalled = append(sutAllActualCalled, data.SUT.actualCalled)
+	})
+
+	assert.Equal(t, []string{"Test_Run/A", "Test_Run/B"}, actualNames)
+	assert.Equal(t, []string{"assertA", "assertB"}, actualAsserts)
+	assert.Equal(t, [][]string{
+		{"sut-stateA", "sut-specA"},
+		{"sut-stateA", "sut-stateB"},
+	}, sutAllActualCalled)
+}
+
+func Test_TestDataFromSlice_Parent(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "root",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "root")
+			},
+		},
+		{
+			Name: "regular",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "regular")
+			},
+		},
+		{
+			Name:   "admin",
+			Parent: "root",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "admin")
+			},
+		},
+		{
+			Name:   "unknown",
+			Parent: "does not exist",
+		},
+	}
+
+	data, err := TestDataFromSlice(t, 1, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-root", "sut-regular"}, data.SUT.actualCalled)
+
+	data, err = TestDataFromSlice(t, 2, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-root", "sut-admin"}, data.SUT.actualCalled)
+
+	_, err = TestDataFromSlice(t, 3, tests)
+	require.ErrorIs(t, err, ErrUnknownParent)
+
+	tests[0].Parent = "admin"
+	_, err = TestDataFromSlice(t, 2, tests)
+	require.ErrorIs(t, err, ErrCyclicInheritance)
+}
+
+func Test_TestDataFromSlice_Initial(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			SpecificBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "specA")
+			},
+		},
+	}
+
+	data, err := TestDataFromSlice(t, 0, tests, testbuilder.Initial(func(t *testing.T) (DummySUT, DummyState) {
+		t.Helper()
+
+		return DummySUT{actualCalled: []string{"sut-initial"}}, DummyState{actualCalled: []string{"state-initial"}}
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sut-initial", "sut-specA"}, data.SUT.actualCalled)
+	assert.Equal(t, []string{"state-initial"}, data.State.actualCalled)
+}
+
+func Test_TestDataFromSlice_Teardown(t *testing.T) {
+	teardowns := make([]string, 0)
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			Teardown: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				teardowns = append(teardowns, "teardownA")
+			},
+		},
+		{
+			Name: "B",
+			Teardown: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				teardowns = append(teardowns, "teardownB")
+			},
+		},
+	}
+
+	t.Run("B", func(t *testing.T) {
+		_, err := TestDataFromSlice(t, 1, tests)
+		require.NoError(t, err)
+		assert.Empty(t, teardowns)
+	})
+
+	assert.Equal(t, []string{"teardownB", "teardownA"}, teardowns)
+}
+
+func Test_TestDataFromSlice_BuilderErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			StateSteps: []testbuilder.Step[DummySUT, DummyState]{
+				testbuilder.NewStepE("", func(t *testing.T, sut *DummySUT, state *DummyState) error {
+					t.Helper()
+
+					appendSUT(sut, "stateA")
+
+					return nil
+				}),
+			},
+		},
+		{
+			Name: "B",
+			SpecificSteps: []testbuilder.Step[DummySUT, DummyState]{
+				testbuilder.NewStepE("", func(t *testing.T, sut *DummySUT, state *DummyState) error {
+					t.Helper()
+
+					return assert.AnError
+				}),
+			},
+		},
+	}
+
+	data, err := TestDataFromSlice(t, 0, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-stateA"}, data.SUT.actualCalled)
+
+	_, err = TestDataFromSlice(t, 1, tests)
+
+	var buildErr *testbuilder.BuildError
+	require.ErrorAs(t, err, &buildErr)
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, "B", buildErr.Case)
+}
+
+func Test_TestDataFromSlice_PanicInBuilder_ReportsChainPosition(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{Name: "A"},
+		{
+			Name: "B",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				panic("boom")
+			},
+		},
+		{Name: "C"},
+	}
+
+	_, err := TestDataFromSlice(t, 2, tests)
+
+	var buildErr *testbuilder.BuildError
+	require.ErrorAs(t, err, &buildErr)
+	assert.Equal(t, testbuilder.KindState, buildErr.Kind)
+	assert.EqualError(t, err, "state builder of case 'B' (#1) panicked while building case 'C' (#2): boom")
+}
+
+func Test_TestDataFromSlice_Steps(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			StateSteps: []testbuilder.Step[DummySUT, DummyState]{
+				{Name: "step A", Func: func(t *testing.T, sut *DummySUT, state *DummyState) error {
+					t.Helper()
+
+					appendSUT(sut, "stepA")
+
+					return nil
+				}},
+			},
+		},
+		{
+			Name: "B",
+			SpecificSteps: []testbuilder.Step[DummySUT, DummyState]{
+				{Name: "step B", Func: func(t *testing.T, sut *DummySUT, state *DummyState) error {
+					t.Helper()
+
+					return assert.AnError
+				}},
+			},
+		},
+	}
+
+	data, err := TestDataFromSlice(t, 0, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-stepA"}, data.SUT.actualCalled)
+
+	_, err = TestDataFromSlice(t, 1, tests)
+
+	var buildErr *testbuilder.BuildError
+	require.ErrorAs(t, err, &buildErr)
+	assert.Equal(t, "step B", buildErr.Step)
+}
+
+func Test_TestDataFromSliceVariant(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{
+			Name: "B",
+			Variants: testbuilder.Vary(func(t *testing.T, sut *DummySUT, state *DummyState, label string) {
+				t.Helper()
+
+				appendSUT(sut, label)
+			}, "empty", "blank"),
+		},
+	}
+
+	data, err := TestDataFromSliceVariant(t, 1, 1, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-stateA", "sut-blank"}, data.SUT.actualCalled)
+
+	_, err = TestDataFromSliceVariant(t, 1, 2, tests)
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = TestDataFromSliceVariant(t, 1, -1, tests)
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = TestDataFromSliceVariant(t, 0, 0, []TableTestItem[DummySUT, DummyState, DummyAssert]{})
+	require.ErrorIs(t, err, ErrNoTestsDefined)
+}
+
+func Test_TestDataFromSlice_SkipAndOnly(t *testing.T) {
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			Skip: "not yet",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{
+			Name: "B",
+			Only: true,
+		},
+		{
+			Name: "C",
+		},
+	}
+
+	actualCalled := make(map[string][]string)
+	skipped := make(map[string]bool)
+
+	for i, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Cleanup(func() {
+				skipped[tt.Name] = t.Skipped()
+			})
+
+			data, err := TestDataFromSlice(t, i, tests)
+			require.NoError(t, err)
+
+			actualCalled[tt.Name] = data.SUT.actualCalled
+		})
+	}
+
+	assert.Equal(t, map[string][]string{"B": {"sut-stateA"}}, actualCalled)
+	assert.Equal(t, map[string]bool{"A": true, "B": false, "C": true}, skipped)
+}
+
+func Test_TestDataFromSlice_Tags(t *testing.T) {
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			Tags: []string{"slow"},
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{
+			Name: "B",
+		},
+	}
+
+	actualCalled := make(map[string][]string)
+	skipped := make(map[string]bool)
+
+	for i, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Cleanup(func() {
+				skipped[tt.Name] = t.Skipped()
+			})
+
+			data, err := TestDataFromSlice(t, i, tests, testbuilder.Filter("!slow"))
+			require.NoError(t, err)
+
+			actualCalled[tt.Name] = data.SUT.actualCalled
+		})
+	}
+
+	assert.Equal(t, map[string][]string{"B": {"sut-stateA"}}, actualCalled)
+	assert.Equal(t, map[string]bool{"A": true, "B": false}, skipped)
+}
+
+func Test_ValidateSlice(t *testing.T) {
+	t.Parallel()
+
+	noop := func(t *testing.T, sut *DummySUT, state *DummyState) {
+		t.Helper()
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []TableTestItem[DummySUT, DummyState, func()]{
+			{Name: "A", StateBuilder: noop, Assertion: func() {}},
+			{Name: "B", SpecificBuilder: noop, Assertion: func() {}},
+		}
+
+		require.NoError(t, ValidateSlice(tests))
+	})
+
+	t.Run("problems", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []TableTestItem[DummySUT, DummyState, func()]{
+			{Name: "A", StateBuilder: noop},
+			{Name: "A", SpecificBuilder: noop, Assertion: func() {}},
+		}
+
+		err := ValidateSlice(tests)
+		require.ErrorIs(t, err, ErrNilAssertion)
+		require.ErrorIs(t, err, ErrDuplicateName)
+	})
+
+	t.Run("zero struct assertion", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+			{Name: "A", StateBuilder: noop, Assertion: DummyAssert{"assert0"}},
+			{Name: "B", SpecificBuilder: noop},
+		}
+
+		err := ValidateSlice(tests)
+		require.ErrorIs(t, err, ErrNilAssertion)
+		assert.Equal(t, "case 'B' (#1): nil assertion", err.Error())
+	})
+
+	t.Run("no tests defined", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, ValidateSlice([]TableTestItem[DummySUT, DummyState, func()]{}), ErrNoTestsDefined)
+	})
+}
+
+func Test_FromBuilder_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	builder := testbuilder.TestsBuilder[DummySUT, DummyState, DummyAssert]{}
+	builder.Register("A").
+		WithStateBuilder(func(t *testing.T, sut *DummySUT, state *DummyState) {
+			t.Helper()
+
+			appendSUT(sut, "stateA")
+		}).
+		WithStateBuilderE(func(t *testing.T, sut *DummySUT, state *DummyState) error {
+			t.Helper()
+
+			appendSUT(sut, "stateEA")
+
+			return nil
+		}).
+		WithTeardown(func(t *testing.T, sut *DummySUT, state *DummyState) {
+			t.Helper()
+		}).
+		WithStep("stepA", func(t *testing.T, sut *DummySUT, state *DummyState) {
+			t.Helper()
+		}).
+		WithTags("db").
+		WithAssertion(DummyAssert{"assertA"})
+	builder.Register("B").
+		From("A").
+		Skip("later").
+		Only().
+		WithSpecificBuilder(func(t *testing.T, sut *DummySUT, state *DummyState) {
+			t.Helper()
+
+			appendSUT(sut, "specB")
+		}).
+		WithSpecificBuilderE(func(t *testing.T, sut *DummySUT, state *DummyState) error {
+			t.Helper()
+
+			appendSUT(sut, "specEB")
+
+			return nil
+		}).
+		WithAssertion(DummyAssert{"assertB"})
+
+	tests := FromBuilder(&builder)
+
+	require.Len(t, tests, 2)
+	assert.Equal(t, "A", tests[0].Name)
+	assert.Equal(t, []string{"db"}, tests[0].Tags)
+	assert.NotNil(t, tests[0].Teardown)
+	require.Len(t, tests[0].StateSteps, 3)
+	assert.Equal(t, "stepA", tests[0].StateSteps[2].Name)
+	assert.Equal(t, DummyAssert{"assertA"}, tests[0].Assertion)
+	assert.Equal(t, "A", tests[1].Parent)
+	assert.Equal(t, "later", tests[1].Skip)
+	assert.True(t, tests[1].Only)
+
+	roundTrip := testbuilder.FromSlice(tests)
+	require.Len(t, roundTrip.TestCases, 2)
+
+	for i, testcase := range roundTrip.TestCases {
+		expected := builder.TestCases[i]
+
+		assert.Equal(t, expected.TestName, testcase.TestName)
+		assert.Equal(t, expected.Parent, testcase.Parent)
+		assert.Equal(t, expected.SkipReason, testcase.SkipReason)
+		assert.Equal(t, expected.Focused, testcase.Focused)
+		assert.Equal(t, expected.Tags, testcase.Tags)
+		assert.Equal(t, expected.Assertion, testcase.Assertion)
+		assert.Equal(t, expected.Teardown == nil, testcase.Teardown == nil)
+		assert.Len(t, testcase.StateSteps, len(expected.StateSteps))
+	}
+
+	roundTrip.TestCases[1].SkipReason = ""
+
+	data, err := roundTrip.Build(t, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-stateA", "sut-stateEA", "sut-specB", "sut-specEB"}, data.SUT.actualCalled)
+}
+
+func Test_TestDataByName(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "get user failure",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+			Assertion: DummyAssert{"assertA"},
+		},
+		{
+			Name: "success",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateB")
+			},
+			Assertion: DummyAssert{"assertB"},
+		},
+		{Name: "duplicate"},
+		{Name: "duplicate"},
+	}
+
+	t.Run("by name", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := TestDataByName(t, "success", tests)
+		require.NoError(t, err)
+		assert.Equal(t, "assertB", data.Assert.Name)
+		assert.Equal(t, []string{"sut-stateA", "sut-stateB"}, data.SUT.actualCalled)
+	})
+
+	t.Run("by go test name", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := TestDataByName(t, "get_user_failure", tests)
+		require.NoError(t, err)
+		assert.Equal(t, "assertA", data.Assert.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := TestDataByName(t, "does not exist", tests)
+		require.ErrorIs(t, err, ErrTestNotFound)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := TestDataByName(t, "duplicate", tests)
+		require.ErrorIs(t, err, ErrDuplicateName)
+		assert.EqualError(t, err, `duplicate name: "duplicate" is used by items #2 and #3`)
+	})
+
+	t.Run("no tests defined", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := TestDataByName(t, "success", []TableTestItem[DummySUT, DummyState, DummyAssert]{})
+		require.ErrorIs(t, err, ErrNoTestsDefined)
+	})
+}
+
+func Fuzz_Fuzz_InheritsChain(f *testing.F) {
+	f.Add("payload")
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name: "A",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{Name: "B"},
+	}
+
+	Fuzz(f, "B", tests, func(t *testing.T, sut *DummySUT, _ *DummyState, payload string) {
+		t.Helper()
+
+		appendSUT(sut, payload)
+	}, func(t *testing.T, data testbuilder.TestData[DummySUT, DummyState, DummyAssert]) {
+		t.Helper()
+
+		require.Len(t, data.SUT.actualCalled, 2)
+		assert.Equal(t, "sut-stateA", data.SUT.actualCalled[0])
+	})
+}
+
+func Test_TestDataFromSlice_TB(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItemTB[DummySUT, DummyState, DummyAssert, testing.TB]{
+		{
+			Name: "A",
+			StateBuilder: func(t testing.TB, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+	}
+
+	data, err := TestDataFromSlice(testing.TB(t), 0, tests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sut-stateA"}, data.SUT.actualCalled)
+}
+
+func Benchmark_Benchmark(b *testing.B) {
+	tests := []TableTestItemTB[DummySUT, DummyState, DummyAssert, testing.TB]{
+		{
+			Name: "A",
+			StateBuilder: func(t testing.TB, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{Name: "B"},
+	}
+
+	Benchmark(b, tests, func(b testing.TB, data testbuilder.TestData[DummySUT, DummyState, DummyAssert]) {
+		b.Helper()
+
+		_ = len(data.SUT.actualCalled)
+	})
+}
+
+func Test_Spec(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{
+			Name:        "A",
+			Description: "first",
+			StateBuilder: func(t *testing.T, sut *DummySUT, state *DummyState) {
+				t.Helper()
+			},
+		},
+		{Name: "B"},
+	}
+
+	spec := Spec(tests)
+
+	require.Len(t, spec.Cases, 2)
+	assert.Equal(t, "first", spec.Cases[0].Description)
+	assert.Equal(t, "A", spec.Cases[1].Parent)
+	assert.Equal(t, []testbuilder.SpecStep{{Kind: testbuilder.KindState, Case: "A", Index: 0}}, spec.Cases[1].Steps)
+	assert.Equal(t, tests[0].Description, FromBuilder(testbuilder.FromSlice(tests))[0].Description)
+}
+
+func Test_Spec_Mermaid(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItem[DummySUT, DummyState, DummyAssert]{
+		{Name: "A"},
+		{Name: "B", Parent: "A"},
+		{Name: "C", Parent: "A", Assertion: DummyAssert{"assert2"}},
+	}
+
+	mermaid := Spec(tests).Mermaid()
+
+	assert.Contains(t, mermaid, "\tcase1[\"B<br>no assertion\"]\n")
+	assert.Contains(t, mermaid, "\tcase2[\"C<br>assertion\"]\n")
+	assert.Equal(t, Spec(tests).DOT(), testbuilder.FromSlice(tests).Spec().DOT())
+	assert.Contains(t, mermaid, "\tcase0 --> case1\n\tcase0 -.->|from| case2\n")
+}
+
+func Test_Audit(t *testing.T) {
+	t.Parallel()
+
+	tests := []TableTestItemTB[DummySUT, DummyState, DummyAssert, testing.TB]{
+		{
+			Name: "A",
+			StateBuilder: func(t testing.TB, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendSUT(sut, "stateA")
+			},
+		},
+		{
+			Name: "B",
+			StateBuilder: func(t testing.TB, sut *DummySUT, state *DummyState) {
+				t.Helper()
+
+				appendState(state, "stateB")
+			},
+		},
+	}
+
+	findings := Audit(t, tests, func(t testing.TB, data testbuilder.TestData[DummySUT, DummyState, DummyAssert]) {
+		t.Helper()
+
+		assert.Contains(t, data.SUT.actualCalled, "sut-stateA")
+	})
+
+	require.Len(t, findings, 1)
+	assert.Equal(t, testbuilder.SpecStep{Kind: testbuilder.KindState, Case: "B", Index: 1}, findings[0].Step)
+	assert.Equal(t, []string{"B"}, findings[0].Cases)
+}